@@ -0,0 +1,21 @@
+variable "owner" {
+  type        = string
+  description = "The alias of any team, service, system or domain"
+}
+
+data "opslevel_alias_lookup" "owner" {
+  alias = var.owner
+}
+
+output "owner_id" {
+  value = data.opslevel_alias_lookup.owner.id
+}
+
+data "opslevel_alias_lookup" "platform_team" {
+  alias      = "platform"
+  owner_type = "team"
+}
+
+output "platform_team_aliases" {
+  value = data.opslevel_alias_lookup.platform_team.aliases
+}
@@ -0,0 +1,11 @@
+data "opslevel_check" "example" {
+  identifier = "Z2lkOi8vb3BzbGV2ZWwvQ2hlY2tzOjpUYWdEZWZpbmVkLzIxNDY"
+}
+
+output "check_type" {
+  value = data.opslevel_check.example.type
+}
+
+output "tag_key" {
+  value = data.opslevel_check.example.config.tag_key
+}
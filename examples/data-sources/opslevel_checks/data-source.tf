@@ -0,0 +1,23 @@
+data "opslevel_checks" "all" {}
+
+output "check_names" {
+  value = sort(data.opslevel_checks.all.checks[*].name)
+}
+
+data "opslevel_rubric_level" "bronze" {
+  filter {
+    field = "alias"
+    value = "bronze"
+  }
+}
+
+data "opslevel_checks" "enabled_bronze_tool_usage" {
+  level   = data.opslevel_rubric_level.bronze.id
+  owner   = "platform"
+  type    = "tool_usage"
+  enabled = true
+}
+
+output "tool_categories" {
+  value = data.opslevel_checks.enabled_bronze_tool_usage.checks[*].config.tool_category
+}
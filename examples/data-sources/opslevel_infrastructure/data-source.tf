@@ -0,0 +1,7 @@
+data "opslevel_infrastructure" "orders_db" {
+  identifier = "orders-db"
+}
+
+output "orders_db_engine" {
+  value = jsondecode(data.opslevel_infrastructure.orders_db.data).engine
+}
@@ -0,0 +1,15 @@
+data "opslevel_infrastructures" "all" {}
+
+output "infrastructure_names" {
+  value = sort(data.opslevel_infrastructures.all.infrastructures[*].name)
+}
+
+data "opslevel_infrastructures" "platform_databases" {
+  owner         = "platform"
+  provider_type = "RDS Instance"
+  account       = "production"
+}
+
+output "platform_database_ids" {
+  value = data.opslevel_infrastructures.platform_databases.infrastructures[*].id
+}
@@ -0,0 +1,23 @@
+data "opslevel_scorecard_report" "all" {}
+
+output "scorecard_failing_services" {
+  value = {
+    for scorecard in data.opslevel_scorecard_report.all.scorecards :
+    scorecard.name => [for service in scorecard.services : service.name if service.failing_checks > 0]
+  }
+}
+
+data "opslevel_scorecard_report" "security" {
+  scorecard = "security"
+
+  lifecycle {
+    postcondition {
+      condition     = alltrue([for service in self.scorecards[0].services : service.failing_checks == 0])
+      error_message = "Every service must pass the checks on the security scorecard."
+    }
+  }
+}
+
+output "security_checks" {
+  value = data.opslevel_scorecard_report.security.scorecards[0].checks[*].name
+}
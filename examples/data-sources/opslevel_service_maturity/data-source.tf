@@ -0,0 +1,30 @@
+data "opslevel_rubric_level" "silver" {
+  filter {
+    field = "alias"
+    value = "silver"
+  }
+}
+
+data "opslevel_service_maturity" "checkout" {
+  service = "checkout"
+
+  lifecycle {
+    postcondition {
+      condition     = try(self.level.index, -1) >= data.opslevel_rubric_level.silver.index
+      error_message = "checkout must reach the Silver level before it is promoted."
+    }
+  }
+}
+
+output "checkout_category_levels" {
+  value = { for category in data.opslevel_service_maturity.checkout.categories : category.category.name => try(category.level.name, null) }
+}
+
+data "opslevel_service_maturity" "checkout_security" {
+  service   = "checkout"
+  scorecard = "security"
+}
+
+output "failing_security_checks" {
+  value = [for result in data.opslevel_service_maturity.checkout_security.check_results : result.check_name if result.status != "passed"]
+}
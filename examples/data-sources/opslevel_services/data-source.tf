@@ -21,6 +21,43 @@ data "opslevel_services" "frontend" {
   }
 }
 
+data "opslevel_services" "tier1_go_platform_pci" {
+  filters = [
+    {
+      field = "tier"
+      value = "tier_1"
+    },
+    {
+      field = "language"
+      value = "Go"
+    },
+    {
+      field = "owner"
+      value = "platform"
+    },
+    {
+      field = "tag"
+      value = "pci:true"
+    },
+  ]
+}
+
+data "opslevel_services" "not_retired" {
+  connective = "or"
+  filters = [
+    {
+      field  = "lifecycle"
+      value  = "end-of-life"
+      negate = true
+    },
+    {
+      field = "tag"
+      value = "keep:true"
+    },
+  ]
+  include_properties = true
+}
+
 output "all_services" {
   value = data.opslevel_services.all.services
 }
@@ -41,3 +78,7 @@ output "frontend_services" {
 output "frontend_services_urls" {
   value = sort(data.opslevel_services.frontend.services[*].url)
 }
+
+output "tier1_go_platform_pci_owners" {
+  value = distinct(data.opslevel_services.tier1_go_platform_pci.services[*].owner)
+}
@@ -0,0 +1,7 @@
+ephemeral "opslevel_secret" "github_token" {
+  identifier = "github-token"
+}
+
+provider "github" {
+  token = ephemeral.opslevel_secret.github_token.value
+}
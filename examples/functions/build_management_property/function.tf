@@ -0,0 +1,9 @@
+output "owner_tag_property" {
+  # tag_key_eq:owner
+  value = provider::opslevel::build_management_property("tag", "owner", null)
+}
+
+output "team_prefix_property" {
+  # tag_key_starts_with:team
+  value = provider::opslevel::build_management_property("tag", "team", "starts_with")
+}
@@ -0,0 +1,4 @@
+resource "opslevel_service" "example" {
+  name = "example"
+  tags = [for key, value in var.labels : provider::opslevel::format_tag(key, value)]
+}
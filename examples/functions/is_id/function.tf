@@ -0,0 +1,3 @@
+output "owner_is_id" {
+  value = provider::opslevel::is_id(var.owner)
+}
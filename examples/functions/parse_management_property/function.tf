@@ -0,0 +1,4 @@
+output "parsed_property" {
+  # { property = "tag", tag_key = "owner", tag_operation = "equals" }
+  value = provider::opslevel::parse_management_property("tag_key_eq:owner")
+}
@@ -0,0 +1,4 @@
+locals {
+  tags = [for tag in opslevel_service.example.tags : provider::opslevel::parse_tag(tag)]
+  env  = one([for tag in local.tags : tag.value if tag.key == "env"])
+}
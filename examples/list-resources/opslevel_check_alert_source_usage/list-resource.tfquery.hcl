@@ -0,0 +1,3 @@
+list "opslevel_check_alert_source_usage" "all" {
+  provider = opslevel
+}
@@ -0,0 +1,3 @@
+list "opslevel_check_code_issue" "all" {
+  provider = opslevel
+}
@@ -0,0 +1,3 @@
+list "opslevel_check_custom_event" "all" {
+  provider = opslevel
+}
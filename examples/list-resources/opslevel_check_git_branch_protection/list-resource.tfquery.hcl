@@ -0,0 +1,3 @@
+list "opslevel_check_git_branch_protection" "all" {
+  provider = opslevel
+}
@@ -0,0 +1,3 @@
+list "opslevel_check_has_documentation" "all" {
+  provider = opslevel
+}
@@ -0,0 +1,3 @@
+list "opslevel_check_has_recent_deploy" "all" {
+  provider = opslevel
+}
@@ -0,0 +1,3 @@
+list "opslevel_check_manual" "all" {
+  provider = opslevel
+}
@@ -0,0 +1,3 @@
+list "opslevel_check_package_version" "all" {
+  provider = opslevel
+}
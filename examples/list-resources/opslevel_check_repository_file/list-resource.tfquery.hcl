@@ -0,0 +1,3 @@
+list "opslevel_check_repository_file" "all" {
+  provider = opslevel
+}
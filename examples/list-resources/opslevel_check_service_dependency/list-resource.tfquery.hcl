@@ -0,0 +1,3 @@
+list "opslevel_check_service_dependency" "all" {
+  provider = opslevel
+}
@@ -0,0 +1,3 @@
+list "opslevel_check_tag_defined" "all" {
+  provider = opslevel
+}
@@ -0,0 +1,3 @@
+list "opslevel_check_tool_usage" "all" {
+  provider = opslevel
+}
@@ -0,0 +1,3 @@
+list "opslevel_domain" "all" {
+  provider = opslevel
+}
@@ -0,0 +1,3 @@
+list "opslevel_filter" "all" {
+  provider = opslevel
+}
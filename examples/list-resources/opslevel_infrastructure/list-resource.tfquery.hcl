@@ -0,0 +1,3 @@
+list "opslevel_infrastructure" "all" {
+  provider = opslevel
+}
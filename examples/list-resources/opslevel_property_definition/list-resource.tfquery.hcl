@@ -0,0 +1,3 @@
+list "opslevel_property_definition" "all" {
+  provider = opslevel
+}
@@ -0,0 +1,10 @@
+list "opslevel_service" "backend" {
+  provider = opslevel
+
+  config {
+    filter = {
+      field = "owner"
+      value = "platform"
+    }
+  }
+}
@@ -0,0 +1,3 @@
+list "opslevel_system" "all" {
+  provider = opslevel
+}
@@ -0,0 +1,3 @@
+list "opslevel_team" "all" {
+  provider = opslevel
+}
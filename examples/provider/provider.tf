@@ -1,5 +1,17 @@
 provider "opslevel" {
   api_token = "XXX" // or environment variable OPSLEVEL_API_TOKEN
+
+  default_tags {
+    tags = [
+      "managed-by:terraform",
+      "repo:opslevel-config",
+    ]
+  }
+
+  ignore_tags {
+    keys         = ["k8s_namespace"]
+    key_prefixes = ["aws_", "azure_"]
+  }
 }
 
 resource "opslevel_team" "foo" {
@@ -0,0 +1,5 @@
+# import by id
+terraform import opslevel_infrastructure.example Z2lkOi8vb3BzbGV2ZWwvU2VydmljZS82MDI0
+
+# or by any of its aliases
+terraform import opslevel_infrastructure.example orders-db
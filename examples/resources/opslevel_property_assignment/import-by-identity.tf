@@ -0,0 +1,7 @@
+import {
+  to = opslevel_property_assignment.example
+  identity = {
+    owner      = "my_service"
+    definition = "my_property"
+  }
+}
@@ -13,3 +13,10 @@ resource "opslevel_secret" "my_secret_2" {
   owner = "devs"
   value = "0sd09wer0sdlkjwer90wer098sdfsewr"
 }
+
+resource "opslevel_secret" "my_secret_3" {
+  alias            = "secret-alias-3"
+  owner            = "devs"
+  value_wo         = var.secret_value
+  value_wo_version = 1
+}
@@ -0,0 +1,7 @@
+import {
+  to = opslevel_service_repository.example
+  identity = {
+    service    = "my_service"
+    repository = "github.com:my-org/my-repo"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = opslevel_service_tag.example
+  identity = {
+    service = "my_service"
+    key     = "environment"
+  }
+}
@@ -1 +1,5 @@
-terraform import opslevel_team.example Z2lkOi8vb3BzbGV2ZWwvU2VydmljZS82MDI0
\ No newline at end of file
+# import by id
+terraform import opslevel_team.example Z2lkOi8vb3BzbGV2ZWwvU2VydmljZS82MDI0
+
+# or by any of its aliases
+terraform import opslevel_team.example platform
@@ -0,0 +1,7 @@
+import {
+  to = opslevel_team_tag.example
+  identity = {
+    team = "platform"
+    key  = "environment"
+  }
+}
@@ -10,6 +10,11 @@ import (
 	"github.com/opslevel/opslevel-go/v2026"
 )
 
+// ClientProvider is implemented by the provider data handed to data sources on Configure
+type ClientProvider interface {
+	Client(ctx context.Context) *opslevel.Client
+}
+
 var _ datasource.DataSourceWithConfigure = (*TFDataSourceSingle[any, any])(nil)
 
 type TFDataSourceSingle[TData any, TModel any] struct {
@@ -29,18 +34,18 @@ func (s *TFDataSourceSingle[TData, TModel]) Configure(ctx context.Context, req d
 		return
 	}
 
-	client, ok := req.ProviderData.(*opslevel.Client)
+	provider, ok := req.ProviderData.(ClientProvider)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("expected *opslevel.Client, got: %T please report this issue to the provider developers at %s.", req.ProviderData, providerIssueUrl),
+			fmt.Sprintf("expected internal.ClientProvider, got: %T please report this issue to the provider developers at %s.", req.ProviderData, providerIssueUrl),
 		)
 
 		return
 	}
 
-	s.client = client
+	s.client = provider.Client(ctx)
 }
 
 func (s *TFDataSourceSingle[TData, TModel]) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -101,18 +106,18 @@ func (s *TFDataSourceMulti[TData, TModel]) Configure(ctx context.Context, req da
 		return
 	}
 
-	client, ok := req.ProviderData.(*opslevel.Client)
+	provider, ok := req.ProviderData.(ClientProvider)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("expected *opslevel.Client, got: %T please report this issue to the provider developers at %s.", req.ProviderData, providerIssueUrl),
+			fmt.Sprintf("expected internal.ClientProvider, got: %T please report this issue to the provider developers at %s.", req.ProviderData, providerIssueUrl),
 		)
 
 		return
 	}
 
-	s.client = client
+	s.client = provider.Client(ctx)
 }
 
 func (s *TFDataSourceMulti[TData, TModel]) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -0,0 +1,395 @@
+package fakeopslevel
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// operation is a parsed GraphQL query or mutation
+type operation struct {
+	Kind       string
+	Name       string
+	Selections []*field
+}
+
+// field is one selection of a selection set. Inline fragments are fields named "..." with a TypeCondition.
+type field struct {
+	Alias         string
+	Name          string
+	Arguments     map[string]any
+	Selections    []*field
+	TypeCondition string
+}
+
+// key is the name the field is returned under
+func (f *field) key() string {
+	if f.Alias != "" {
+		return f.Alias
+	}
+	return f.Name
+}
+
+func (f *field) isFragment() bool {
+	return f.Name == "..."
+}
+
+// hasSelection reports whether the field selects name directly
+func (f *field) hasSelection(name string) bool {
+	for _, selection := range f.Selections {
+		if selection.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+type tokenKind int
+
+const (
+	tokenPunctuator tokenKind = iota
+	tokenName
+	tokenString
+	tokenNumber
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+}
+
+// parseOperation parses the subset of GraphQL the OpsLevel client sends: a single named or anonymous
+// operation with variables, arguments, aliases and inline fragments. Variables are substituted while parsing.
+func parseOperation(query string, variables map[string]any) (*operation, error) {
+	tokens, err := tokenize(query)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens, variables: variables}
+
+	op := &operation{Kind: "query"}
+	if p.peekName("query") || p.peekName("mutation") {
+		op.Kind = p.next().value
+		if p.peekKind(tokenName) {
+			op.Name = p.next().value
+		}
+		if p.peekPunctuator("(") {
+			// variable definitions only declare types, the values come from variables
+			if err := p.skipBalanced("(", ")"); err != nil {
+				return nil, err
+			}
+		}
+		if err := p.skipDirectives(); err != nil {
+			return nil, err
+		}
+	} else if p.peekKind(tokenName) {
+		return nil, fmt.Errorf("unsupported operation type '%s'", p.peek().value)
+	}
+
+	op.Selections, err = p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, fmt.Errorf("unexpected '%s' after the operation, only one operation per request is supported", p.peek().value)
+	}
+	return op, nil
+}
+
+func tokenize(query string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			i++
+		case c == '#':
+			for i < len(query) && query[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(query[i:], "..."):
+			tokens = append(tokens, token{kind: tokenPunctuator, value: "..."})
+			i += 3
+		case strings.ContainsRune("{}()[]:$!=@", rune(c)):
+			tokens = append(tokens, token{kind: tokenPunctuator, value: string(c)})
+			i++
+		case c == '"':
+			end := i + 1
+			for end < len(query) && query[end] != '"' {
+				if query[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(query) {
+				return nil, fmt.Errorf("unterminated string at offset %d", i)
+			}
+			value, err := strconv.Unquote(query[i : end+1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid string at offset %d: %w", i, err)
+			}
+			tokens = append(tokens, token{kind: tokenString, value: value})
+			i = end + 1
+		case c == '-' || (c >= '0' && c <= '9'):
+			end := i + 1
+			for end < len(query) && strings.ContainsRune("0123456789.eE+-", rune(query[end])) {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, value: query[i:end]})
+			i = end
+		case c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+			end := i + 1
+			for end < len(query) && isNameChar(query[end]) {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenName, value: query[i:end]})
+			i = end
+		default:
+			return nil, fmt.Errorf("unexpected character '%c' at offset %d", c, i)
+		}
+	}
+	return tokens, nil
+}
+
+func isNameChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+type parser struct {
+	tokens    []token
+	position  int
+	variables map[string]any
+}
+
+func (p *parser) done() bool {
+	return p.position >= len(p.tokens)
+}
+
+func (p *parser) peek() token {
+	if p.done() {
+		return token{kind: tokenPunctuator, value: "<end>"}
+	}
+	return p.tokens[p.position]
+}
+
+func (p *parser) next() token {
+	current := p.peek()
+	p.position++
+	return current
+}
+
+func (p *parser) peekKind(kind tokenKind) bool {
+	return !p.done() && p.peek().kind == kind
+}
+
+func (p *parser) peekName(name string) bool {
+	return p.peekKind(tokenName) && p.peek().value == name
+}
+
+func (p *parser) peekPunctuator(value string) bool {
+	return p.peekKind(tokenPunctuator) && p.peek().value == value
+}
+
+func (p *parser) expect(value string) error {
+	if got := p.next(); got.kind != tokenPunctuator || got.value != value {
+		return fmt.Errorf("expected '%s', got '%s'", value, got.value)
+	}
+	return nil
+}
+
+func (p *parser) expectName() (string, error) {
+	got := p.next()
+	if got.kind != tokenName {
+		return "", fmt.Errorf("expected a name, got '%s'", got.value)
+	}
+	return got.value, nil
+}
+
+func (p *parser) skipBalanced(open, close string) error {
+	depth := 0
+	for !p.done() {
+		current := p.next()
+		if current.kind != tokenPunctuator {
+			continue
+		}
+		switch current.value {
+		case open:
+			depth++
+		case close:
+			depth--
+			if depth == 0 {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("missing '%s'", close)
+}
+
+// skipDirectives ignores directives such as @include, the client never relies on them
+func (p *parser) skipDirectives() error {
+	for p.peekPunctuator("@") {
+		p.next()
+		if _, err := p.expectName(); err != nil {
+			return err
+		}
+		if p.peekPunctuator("(") {
+			if err := p.skipBalanced("(", ")"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (p *parser) parseSelectionSet() ([]*field, error) {
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	var selections []*field
+	for !p.peekPunctuator("}") {
+		if p.done() {
+			return nil, fmt.Errorf("missing '}'")
+		}
+		selection, err := p.parseSelection()
+		if err != nil {
+			return nil, err
+		}
+		selections = append(selections, selection)
+	}
+	p.next()
+	return selections, nil
+}
+
+func (p *parser) parseSelection() (*field, error) {
+	if p.peekPunctuator("...") {
+		p.next()
+		if !p.peekName("on") {
+			return nil, fmt.Errorf("named fragments are not supported")
+		}
+		p.next()
+		typeCondition, err := p.expectName()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.skipDirectives(); err != nil {
+			return nil, err
+		}
+		selections, err := p.parseSelectionSet()
+		if err != nil {
+			return nil, err
+		}
+		return &field{Name: "...", TypeCondition: typeCondition, Selections: selections}, nil
+	}
+
+	name, err := p.expectName()
+	if err != nil {
+		return nil, err
+	}
+	selection := &field{Name: name}
+	if p.peekPunctuator(":") {
+		p.next()
+		selection.Alias = name
+		if selection.Name, err = p.expectName(); err != nil {
+			return nil, err
+		}
+	}
+	if p.peekPunctuator("(") {
+		if selection.Arguments, err = p.parseArguments(); err != nil {
+			return nil, err
+		}
+	}
+	if err := p.skipDirectives(); err != nil {
+		return nil, err
+	}
+	if p.peekPunctuator("{") {
+		if selection.Selections, err = p.parseSelectionSet(); err != nil {
+			return nil, err
+		}
+	}
+	return selection, nil
+}
+
+func (p *parser) parseArguments() (map[string]any, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	arguments := map[string]any{}
+	for !p.peekPunctuator(")") {
+		name, err := p.expectName()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(":"); err != nil {
+			return nil, err
+		}
+		if arguments[name], err = p.parseValue(); err != nil {
+			return nil, err
+		}
+	}
+	p.next()
+	return arguments, nil
+}
+
+func (p *parser) parseValue() (any, error) {
+	current := p.next()
+	switch current.kind {
+	case tokenString:
+		return current.value, nil
+	case tokenNumber:
+		if integer, err := strconv.ParseInt(current.value, 10, 64); err == nil {
+			return float64(integer), nil
+		}
+		return strconv.ParseFloat(current.value, 64)
+	case tokenName:
+		switch current.value {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		}
+		// enum values are passed around as strings, like they are in JSON variables
+		return current.value, nil
+	}
+
+	switch current.value {
+	case "$":
+		name, err := p.expectName()
+		if err != nil {
+			return nil, err
+		}
+		return p.variables[name], nil
+	case "[":
+		values := []any{}
+		for !p.peekPunctuator("]") {
+			if p.done() {
+				return nil, fmt.Errorf("missing ']'")
+			}
+			value, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		p.next()
+		return values, nil
+	case "{":
+		object := map[string]any{}
+		for !p.peekPunctuator("}") {
+			name, err := p.expectName()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(":"); err != nil {
+				return nil, err
+			}
+			if object[name], err = p.parseValue(); err != nil {
+				return nil, err
+			}
+		}
+		p.next()
+		return object, nil
+	}
+	return nil, fmt.Errorf("unexpected '%s' where a value was expected", current.value)
+}
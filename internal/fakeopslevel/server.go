@@ -0,0 +1,547 @@
+// Package fakeopslevel is an in-memory stand-in for the OpsLevel GraphQL API, used to test the provider offline.
+//
+// It does not know the OpsLevel schema. Objects are stored as maps under the name of their GraphQL field,
+// such as "team" or "category", and the API is implemented by convention:
+//
+//   - `account { id name }` describes the single fake account, see AccountId and AccountName
+//   - `account { team(id: $id) }` and `account { team(alias: $alias) }` return one object, `account { teams }` a connection of all of them
+//   - `teamCreate(input: $input)` stores the input as a new object with an id and an alias derived from its name
+//   - `teamUpdate(input: $input)` merges the input into the object found by the id or alias in its arguments
+//   - `teamDelete(input: $input)` removes the object found by the id or alias in its arguments
+//   - `checkManualCreate(input: $input)` and `checkManualUpdate(input: $input)` manage a "check" of typename ManualCheck,
+//     as every check type is read with `account { check(id: $id) }` and told apart by fragments on its typename
+//
+// Responses only contain the fields a request selects, as the client refuses fields it didn't ask for.
+// Input fields ending in `Id`, such as `ownerId`, also link the object they name, so `owner { id }` can be selected.
+// So do identifier inputs, such as `ownerInput: {alias: "platform"}` or `parentTeam: {id: ...}`.
+package fakeopslevel
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// AccountId and AccountName describe the account every fake API belongs to
+const (
+	AccountId   = "Z2lkOi8vb3BzbGV2ZWwvQWNjb3VudC8x"
+	AccountName = "Fake Account"
+)
+
+// Server is a fake OpsLevel GraphQL API listening on a local port
+type Server struct {
+	httpServer *httptest.Server
+
+	mu         sync.Mutex
+	objects    map[string][]map[string]any
+	nextId     int
+	operations []string
+}
+
+// NewServer starts a fake OpsLevel API, callers must Close it
+func NewServer() *Server {
+	s := &Server{objects: map[string][]map[string]any{}}
+	s.httpServer = httptest.NewServer(s)
+	return s
+}
+
+// URL is the value to use as the provider api_url
+func (s *Server) URL() string {
+	return s.httpServer.URL
+}
+
+func (s *Server) Close() {
+	s.httpServer.Close()
+}
+
+// Seed stores an object of the given kind as if it was created through the API and returns its id
+func (s *Server) Seed(kind string, object map[string]any) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.create(kind, typeName(kind), object)["id"].(string)
+}
+
+// Object returns a copy of the object of the given kind with the given id or alias, or nil
+func (s *Server) Object(kind string, identifier string) map[string]any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	object := s.find(kind, identifier)
+	if object == nil {
+		return nil
+	}
+	return deepCopy(object).(map[string]any)
+}
+
+// Remove deletes an object behind the provider's back, as if it was deleted in the OpsLevel UI
+func (s *Server) Remove(kind string, identifier string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.remove(kind, identifier) != nil
+}
+
+// Operations returns the root field of every request served so far, such as "teamCreate" or "account.team"
+func (s *Server) Operations() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.operations...)
+}
+
+type graphQLError struct {
+	Message string `json:"message"`
+	Path    []any  `json:"path,omitempty"`
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		Query     string         `json:"query"`
+		Variables map[string]any `json:"variables"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeResponse(w, nil, []graphQLError{{Message: fmt.Sprintf("invalid request body: %s", err)}})
+		return
+	}
+	op, err := parseOperation(request.Query, request.Variables)
+	if err != nil {
+		writeResponse(w, nil, []graphQLError{{Message: fmt.Sprintf("unable to parse query: %s", err)}})
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, errs := s.execute(op)
+	writeResponse(w, data, errs)
+}
+
+func writeResponse(w http.ResponseWriter, data map[string]any, errs []graphQLError) {
+	response := map[string]any{"data": data}
+	if len(errs) > 0 {
+		response["errors"] = errs
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func (s *Server) execute(op *operation) (map[string]any, []graphQLError) {
+	data := map[string]any{}
+	var errs []graphQLError
+	for _, selection := range op.Selections {
+		var value any
+		var err error
+		switch {
+		case selection.Name == "__typename":
+			value = strings.ToUpper(op.Kind[:1]) + op.Kind[1:]
+		case op.Kind == "mutation":
+			s.operations = append(s.operations, selection.Name)
+			value, err = s.mutate(selection)
+		case selection.Name == "account":
+			data[selection.key()], err = s.account(selection)
+			if err == nil {
+				continue
+			}
+		default:
+			err = fmt.Errorf("the fake OpsLevel API does not implement the query '%s'", selection.Name)
+		}
+		if err != nil {
+			errs = append(errs, graphQLError{Message: err.Error(), Path: []any{selection.key()}})
+			data[selection.key()] = nil
+			continue
+		}
+		data[selection.key()] = project(value, selection)
+	}
+	return data, errs
+}
+
+// account resolves and projects the fields of the `account` query root, each on its own as they can take arguments
+func (s *Server) account(account *field) (map[string]any, error) {
+	resolved := map[string]any{}
+	for _, selection := range account.Selections {
+		var value any
+		switch {
+		case selection.isFragment():
+			continue
+		case selection.Name == "__typename":
+			value = "Account"
+		case selection.Name == "id":
+			value = AccountId
+		case selection.Name == "name":
+			value = AccountName
+		case selection.Name == "rubric":
+			value = map[string]any{
+				"categories": s.connection("category"),
+				"levels":     s.connection("level"),
+			}
+		case identifierArgument(selection.Arguments) != "":
+			// a missing object is null, like the API returns for an unknown id
+			if object := s.find(selection.Name, identifierArgument(selection.Arguments)); object != nil {
+				value = object
+			}
+		case singular(selection.Name) != selection.Name:
+			value = s.connection(singular(selection.Name))
+		default:
+			return nil, fmt.Errorf("the fake OpsLevel API does not implement the query 'account.%s'", selection.Name)
+		}
+		if selection.Name != "__typename" {
+			s.operations = append(s.operations, "account."+selection.Name)
+		}
+		resolved[selection.key()] = project(value, selection)
+	}
+	return resolved, nil
+}
+
+var mutationName = regexp.MustCompile(`^([a-z][A-Za-z]*?)(Create|Update|Delete)$`)
+
+func (s *Server) mutate(mutation *field) (map[string]any, error) {
+	switch mutation.Name {
+	case "aliasCreate":
+		return s.createAlias(mutation.Arguments), nil
+	case "aliasDelete":
+		return s.deleteAlias(mutation.Arguments), nil
+	}
+
+	match := mutationName.FindStringSubmatch(mutation.Name)
+	if match == nil {
+		return nil, fmt.Errorf("the fake OpsLevel API does not implement the mutation '%s'", mutation.Name)
+	}
+	kind, verb := match[1], match[2]
+	typename := typeName(kind)
+	if checkType, ok := strings.CutPrefix(typename, "Check"); ok && checkType != "" {
+		kind, typename = "check", checkType+"Check"
+	}
+	input, _ := mutation.Arguments["input"].(map[string]any)
+
+	if verb == "Create" {
+		return map[string]any{kind: s.create(kind, typename, input), "errors": []any{}}, nil
+	}
+
+	identifier := identifierArgument(mutation.Arguments)
+	object := s.find(kind, identifier)
+	if object == nil {
+		return map[string]any{kind: nil, "errors": []any{notFoundError(kind, identifier)}}, nil
+	}
+	if verb == "Update" {
+		s.apply(object, input)
+		return map[string]any{kind: object, "errors": []any{}}, nil
+	}
+
+	s.remove(kind, identifier)
+	return map[string]any{
+		"deletedId":                          object["id"],
+		"deleted" + typeName(kind) + "Id":    object["id"],
+		"deletedAlias":                       object["alias"],
+		"deleted" + typeName(kind) + "Alias": object["alias"],
+		"errors":                             []any{},
+	}, nil
+}
+
+func (s *Server) createAlias(arguments map[string]any) map[string]any {
+	input, _ := arguments["input"].(map[string]any)
+	alias, _ := input["alias"].(string)
+	ownerId, _ := input["ownerId"].(string)
+	owner := s.findAny(ownerId)
+	if owner == nil {
+		return map[string]any{"aliases": nil, "errors": []any{notFoundError("owner", ownerId)}}
+	}
+	aliases := toStrings(owner["aliases"])
+	if !contains(aliases, alias) {
+		aliases = append(aliases, alias)
+	}
+	owner["aliases"] = toAny(aliases)
+	return map[string]any{"aliases": owner["aliases"], "ownerId": ownerId, "errors": []any{}}
+}
+
+func (s *Server) deleteAlias(arguments map[string]any) map[string]any {
+	input, _ := arguments["input"].(map[string]any)
+	alias, _ := input["alias"].(string)
+	owner := s.findAny(alias)
+	if owner == nil {
+		return map[string]any{"deleted": nil, "errors": []any{notFoundError("alias", alias)}}
+	}
+	var aliases []string
+	for _, existing := range toStrings(owner["aliases"]) {
+		if existing != alias {
+			aliases = append(aliases, existing)
+		}
+	}
+	owner["aliases"] = toAny(aliases)
+	return map[string]any{"deleted": alias, "errors": []any{}}
+}
+
+func notFoundError(kind string, identifier string) map[string]any {
+	return map[string]any{
+		"message": fmt.Sprintf("%s with id or alias '%s' not found", typeName(kind), identifier),
+		"path":    []any{"input"},
+	}
+}
+
+func (s *Server) create(kind string, typename string, input map[string]any) map[string]any {
+	s.nextId++
+	object := map[string]any{
+		"__typename": typename,
+		"id":         newId(typename, s.nextId),
+	}
+	if name, ok := input["name"].(string); ok && input["alias"] == nil {
+		object["alias"] = slug(name)
+	}
+	s.apply(object, input)
+	if alias, ok := object["alias"].(string); ok && object["aliases"] == nil {
+		object["aliases"] = []any{alias}
+		object["managedAliases"] = []any{alias}
+	}
+	object["htmlUrl"] = fmt.Sprintf("https://app.opslevel.com/%s/%d", kind, s.nextId)
+	s.objects[kind] = append(s.objects[kind], object)
+	return object
+}
+
+// apply merges input into an object, linking the objects that `xId` fields or identifier inputs name.
+// An empty `xInput` identifier unlinks the object, like the API does for `ownerInput: {}`.
+func (s *Server) apply(object map[string]any, input map[string]any) {
+	for key, value := range input {
+		if key == "id" {
+			continue
+		}
+		value = deepCopy(value)
+		object[key] = value
+		if linked, ok := strings.CutSuffix(key, "Id"); ok && linked != "" {
+			if identifier, ok := value.(string); ok {
+				object[linked] = s.link(identifier)
+			} else if value == nil {
+				object[linked] = nil
+			}
+		} else if linked, ok := strings.CutSuffix(key, "Input"); ok && linked != "" {
+			if nested, ok := value.(map[string]any); ok {
+				object[linked] = s.link(identifierArgument(nested))
+			} else if value == nil {
+				object[linked] = nil
+			}
+		} else if nested, ok := value.(map[string]any); ok {
+			if linkedObject := s.findAny(identifierArgument(nested)); linkedObject != nil {
+				object[key] = linkedObject
+			}
+		}
+	}
+}
+
+func (s *Server) find(kind string, identifier string) map[string]any {
+	if identifier == "" {
+		return nil
+	}
+	for _, object := range s.objects[kind] {
+		if object["id"] == identifier || object["alias"] == identifier || contains(toStrings(object["aliases"]), identifier) {
+			return object
+		}
+	}
+	return nil
+}
+
+func (s *Server) findAny(identifier string) map[string]any {
+	kinds := make([]string, 0, len(s.objects))
+	for kind := range s.objects {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		if object := s.find(kind, identifier); object != nil {
+			return object
+		}
+	}
+	return nil
+}
+
+// link returns the object an identifier names, or nil so a missing object is selected as null
+func (s *Server) link(identifier string) any {
+	if object := s.findAny(identifier); object != nil {
+		return object
+	}
+	return nil
+}
+
+func (s *Server) remove(kind string, identifier string) map[string]any {
+	object := s.find(kind, identifier)
+	if object == nil {
+		return nil
+	}
+	objects := s.objects[kind][:0]
+	for _, existing := range s.objects[kind] {
+		if existing["id"] != object["id"] {
+			objects = append(objects, existing)
+		}
+	}
+	s.objects[kind] = objects
+	return object
+}
+
+// connection returns every object of a kind as a single page
+func (s *Server) connection(kind string) map[string]any {
+	nodes := []any{}
+	edges := []any{}
+	for _, object := range s.objects[kind] {
+		nodes = append(nodes, object)
+		edges = append(edges, map[string]any{"cursor": object["id"], "node": object})
+	}
+	return map[string]any{
+		"nodes":      nodes,
+		"edges":      edges,
+		"pageInfo":   emptyPageInfo(),
+		"totalCount": float64(len(nodes)),
+	}
+}
+
+func emptyPageInfo() map[string]any {
+	return map[string]any{"hasNextPage": false, "hasPreviousPage": false, "startCursor": "", "endCursor": ""}
+}
+
+// identifierArgument finds the id or alias an operation is about: an `id` or `alias` argument,
+// or the `id` or `alias` of an input object such as `input: {id: ...}` or `domain: {alias: ...}`
+func identifierArgument(arguments map[string]any) string {
+	for _, key := range []string{"id", "alias"} {
+		if value, ok := arguments[key].(string); ok && value != "" {
+			return value
+		}
+	}
+	keys := make([]string, 0, len(arguments))
+	for key := range arguments {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if input, ok := arguments[key].(map[string]any); ok {
+			for _, identifierKey := range []string{"id", "alias"} {
+				if value, ok := input[identifierKey].(string); ok && value != "" {
+					return value
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// project keeps only the fields the request selects, filling in empty connections the client can page through
+func project(value any, selection *field) any {
+	if len(selection.Selections) == 0 {
+		return value
+	}
+	switch typed := value.(type) {
+	case map[string]any:
+		return projectObject(typed, selection.Selections)
+	case []any:
+		projected := make([]any, len(typed))
+		for i, item := range typed {
+			projected[i] = project(item, selection)
+		}
+		return projected
+	case nil:
+		if selection.Name == "pageInfo" {
+			return projectObject(emptyPageInfo(), selection.Selections)
+		}
+		if selection.hasSelection("nodes") || selection.hasSelection("edges") {
+			return projectObject(map[string]any{
+				"nodes":      []any{},
+				"edges":      []any{},
+				"pageInfo":   emptyPageInfo(),
+				"totalCount": float64(0),
+			}, selection.Selections)
+		}
+	}
+	return value
+}
+
+func projectObject(object map[string]any, selections []*field) map[string]any {
+	projected := map[string]any{}
+	for _, selection := range selections {
+		if selection.isFragment() {
+			typename, ok := object["__typename"]
+			if !ok || typename == selection.TypeCondition {
+				for key, value := range projectObject(object, selection.Selections) {
+					projected[key] = value
+				}
+			}
+			continue
+		}
+		projected[selection.key()] = project(object[selection.Name], selection)
+	}
+	return projected
+}
+
+// newId returns an id shaped like OpsLevel's, the base64 of a gid. The number is zero padded
+// so the gid encodes without padding, which keeps the id the same in every base64 alphabet.
+func newId(typename string, number int) string {
+	prefix := "gid://opslevel/" + typename + "/"
+	suffix := strconv.Itoa(number)
+	for (len(prefix)+len(suffix))%3 != 0 {
+		suffix = "0" + suffix
+	}
+	return base64.RawStdEncoding.EncodeToString([]byte(prefix + suffix))
+}
+
+var nonAliasCharacters = regexp.MustCompile(`[^a-z0-9]+`)
+
+func slug(name string) string {
+	return strings.Trim(nonAliasCharacters.ReplaceAllString(strings.ToLower(name), "_"), "_")
+}
+
+func typeName(kind string) string {
+	if kind == "" {
+		return ""
+	}
+	return strings.ToUpper(kind[:1]) + kind[1:]
+}
+
+func singular(name string) string {
+	if trimmed, ok := strings.CutSuffix(name, "ies"); ok {
+		return trimmed + "y"
+	}
+	return strings.TrimSuffix(name, "s")
+}
+
+func deepCopy(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		copied := make(map[string]any, len(typed))
+		for key, item := range typed {
+			copied[key] = deepCopy(item)
+		}
+		return copied
+	case []any:
+		copied := make([]any, len(typed))
+		for i, item := range typed {
+			copied[i] = deepCopy(item)
+		}
+		return copied
+	}
+	return value
+}
+
+func toStrings(value any) []string {
+	items, _ := value.([]any)
+	output := make([]string, 0, len(items))
+	for _, item := range items {
+		if text, ok := item.(string); ok {
+			output = append(output, text)
+		}
+	}
+	return output
+}
+
+func toAny(values []string) []any {
+	output := make([]any, len(values))
+	for i, value := range values {
+		output[i] = value
+	}
+	return output
+}
+
+func contains(values []string, value string) bool {
+	for _, item := range values {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
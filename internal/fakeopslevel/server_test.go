@@ -0,0 +1,277 @@
+package fakeopslevel
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func post(t *testing.T, server *Server, query string, variables map[string]any) (map[string]any, []any) {
+	t.Helper()
+	body, err := json.Marshal(map[string]any{"query": query, "variables": variables})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.Post(server.URL()+"/graphql", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var response struct {
+		Data   map[string]any `json:"data"`
+		Errors []any          `json:"errors"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		t.Fatal(err)
+	}
+	return response.Data, response.Errors
+}
+
+func TestParseOperation(t *testing.T) {
+	op, err := parseOperation(
+		`mutation TeamCreate($input:TeamCreateInput!){teamCreate(input: $input){team{id,name,owner{... on Team{alias}}},errors{message,path}}}`,
+		map[string]any{"input": map[string]any{"name": "Platform"}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if op.Kind != "mutation" || op.Name != "TeamCreate" {
+		t.Errorf("expected mutation TeamCreate, got %s %s", op.Kind, op.Name)
+	}
+	create := op.Selections[0]
+	if create.Name != "teamCreate" || !reflect.DeepEqual(create.Arguments, map[string]any{"input": map[string]any{"name": "Platform"}}) {
+		t.Errorf("unexpected root field %+v", create)
+	}
+	owner := create.Selections[0].Selections[2]
+	if !owner.Selections[0].isFragment() || owner.Selections[0].TypeCondition != "Team" {
+		t.Errorf("expected an inline fragment on Team, got %+v", owner.Selections[0])
+	}
+
+	op, err = parseOperation(`{account{mine: team(alias: "platform", first: 10){id}}}`, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	team := op.Selections[0].Selections[0]
+	if op.Kind != "query" || team.key() != "mine" || team.Name != "team" {
+		t.Errorf("expected the aliased team field, got %+v", team)
+	}
+	if !reflect.DeepEqual(team.Arguments, map[string]any{"alias": "platform", "first": float64(10)}) {
+		t.Errorf("unexpected literal arguments %v", team.Arguments)
+	}
+
+	for _, query := range []string{`{account{team(id: "x"){id}}`, `query { ...TeamFields }`, `subscription {x}`} {
+		if _, err := parseOperation(query, nil); err == nil {
+			t.Errorf("expected an error parsing '%s'", query)
+		}
+	}
+}
+
+func TestServerCRUD(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	data, errs := post(t, server,
+		`mutation CategoryCreate($input:CategoryCreateInput!){categoryCreate(input: $input){category{id,name},errors{message,path}}}`,
+		map[string]any{"input": map[string]any{"name": "Security"}},
+	)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+	category := data["categoryCreate"].(map[string]any)["category"].(map[string]any)
+	id := category["id"].(string)
+	if category["name"] != "Security" || len(category) != 2 {
+		t.Errorf("expected only the selected fields, got %v", category)
+	}
+
+	get := `query CategoryGet($id:ID!){account{category(id: $id){id,name}}}`
+	data, _ = post(t, server, get, map[string]any{"id": id})
+	if got := data["account"].(map[string]any)["category"].(map[string]any)["name"]; got != "Security" {
+		t.Errorf("expected to read back the category, got %v", got)
+	}
+
+	data, _ = post(t, server,
+		`mutation CategoryUpdate($input:CategoryUpdateInput!){categoryUpdate(input: $input){category{name},errors{message}}}`,
+		map[string]any{"input": map[string]any{"id": id, "name": "Reliability"}},
+	)
+	if got := data["categoryUpdate"].(map[string]any)["category"].(map[string]any)["name"]; got != "Reliability" {
+		t.Errorf("expected the updated name, got %v", got)
+	}
+
+	data, _ = post(t, server,
+		`query CategoryList($after:String!$first:Int!){account{rubric{categories(after: $after, first: $first){nodes{name},pageInfo{hasNextPage},totalCount}}}}`,
+		map[string]any{"after": "", "first": 100},
+	)
+	categories := data["account"].(map[string]any)["rubric"].(map[string]any)["categories"].(map[string]any)
+	if categories["totalCount"] != float64(1) || categories["pageInfo"].(map[string]any)["hasNextPage"] != false {
+		t.Errorf("expected a single page with one category, got %v", categories)
+	}
+
+	data, _ = post(t, server,
+		`mutation CategoryDelete($input:CategoryDeleteInput!){categoryDelete(input: $input){deletedCategoryId,errors{message}}}`,
+		map[string]any{"input": map[string]any{"id": id}},
+	)
+	if got := data["categoryDelete"].(map[string]any)["deletedCategoryId"]; got != id {
+		t.Errorf("expected the deleted id, got %v", got)
+	}
+
+	data, _ = post(t, server, get, map[string]any{"id": id})
+	if got := data["account"].(map[string]any)["category"]; got != nil {
+		t.Errorf("expected a deleted category to be null, got %v", got)
+	}
+	data, _ = post(t, server,
+		`mutation CategoryDelete($input:CategoryDeleteInput!){categoryDelete(input: $input){deletedCategoryId,errors{message}}}`,
+		map[string]any{"input": map[string]any{"id": id}},
+	)
+	if errs := data["categoryDelete"].(map[string]any)["errors"].([]any); len(errs) != 1 {
+		t.Errorf("expected deleting a deleted category to fail, got %v", errs)
+	}
+}
+
+func TestServerLinksAndConnections(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	teamId := server.Seed("team", map[string]any{"name": "Platform Team"})
+	data, errs := post(t, server,
+		`mutation DomainCreate($input:DomainInput!){domainCreate(input: $input){domain{id,aliases,owner{... on Team{alias,id}},tags{nodes{key},pageInfo{hasNextPage}}},errors{message}}}`,
+		map[string]any{"input": map[string]any{"name": "Payments", "ownerId": teamId}},
+	)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+	domain := data["domainCreate"].(map[string]any)["domain"].(map[string]any)
+	if owner := domain["owner"].(map[string]any); owner["id"] != teamId || owner["alias"] != "platform_team" {
+		t.Errorf("expected the owner to be linked, got %v", owner)
+	}
+	if !reflect.DeepEqual(domain["aliases"], []any{"payments"}) {
+		t.Errorf("expected an alias derived from the name, got %v", domain["aliases"])
+	}
+	if tags := domain["tags"].(map[string]any); !reflect.DeepEqual(tags["nodes"], []any{}) {
+		t.Errorf("expected an empty connection, got %v", tags)
+	}
+
+	// domainUpdate takes the domain identifier outside of the input
+	data, _ = post(t, server,
+		`mutation DomainUpdate($domain:IdentifierInput!$input:DomainInput!){domainUpdate(domain: $domain, input: $input){domain{note,owner{... on Team{id}}},errors{message}}}`,
+		map[string]any{"domain": map[string]any{"alias": "payments"}, "input": map[string]any{"note": "pci", "ownerId": nil}},
+	)
+	domain = data["domainUpdate"].(map[string]any)["domain"].(map[string]any)
+	if domain["note"] != "pci" || domain["owner"] != nil {
+		t.Errorf("expected the note to be set and the owner cleared, got %v", domain)
+	}
+
+	if !server.Remove("team", "platform_team") || server.Object("team", teamId) != nil {
+		t.Error("expected the team to be removed")
+	}
+	if got := strings.Join(server.Operations(), ","); got != "domainCreate,domainUpdate" {
+		t.Errorf("unexpected operations %s", got)
+	}
+
+	_, errs = post(t, server, `mutation {serviceRepositoryCreate(input: {}){errors{message}}}`, nil)
+	if len(errs) != 0 {
+		t.Errorf("expected create to be implemented for any kind, got %v", errs)
+	}
+	_, errs = post(t, server, `mutation {tagAssign(input: {}){errors{message}}}`, nil)
+	if len(errs) != 1 {
+		t.Errorf("expected an error for an unimplemented mutation, got %v", errs)
+	}
+}
+
+func TestNewId(t *testing.T) {
+	for _, typename := range []string{"Team", "Category", "Level", "Domain"} {
+		for number := 1; number < 1000; number *= 7 {
+			id := newId(typename, number)
+			decoded, err := base64.StdEncoding.DecodeString(id)
+			if err != nil || !strings.HasPrefix(string(decoded), "gid://opslevel/"+typename+"/") {
+				t.Errorf("expected '%s' to decode to a %s gid, got '%s' %v", id, typename, decoded, err)
+			}
+		}
+	}
+}
+
+func TestServerAccount(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	data, errs := post(t, server, `{account{id,name}}`, nil)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	expected := map[string]any{"id": AccountId, "name": AccountName}
+	if !reflect.DeepEqual(data["account"], expected) {
+		t.Errorf("expected %v, got %v", expected, data["account"])
+	}
+}
+
+func TestServerIdentifierInputs(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	teamId := server.Seed("team", map[string]any{"name": "Platform"})
+	data, errs := post(t, server,
+		`mutation ServiceCreate($input:ServiceCreateInput!){serviceCreate(input: $input){service{id,owner{alias,id}},errors{message}}}`,
+		map[string]any{"input": map[string]any{"name": "Checkout", "ownerInput": map[string]any{"alias": "platform"}}},
+	)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+	service := data["serviceCreate"].(map[string]any)["service"].(map[string]any)
+	if owner := service["owner"].(map[string]any); owner["id"] != teamId {
+		t.Errorf("expected the owner to be linked by its alias, got %v", owner)
+	}
+
+	data, _ = post(t, server,
+		`mutation ServiceUpdate($input:ServiceUpdateInput!){serviceUpdate(input: $input){service{owner{id}},errors{message}}}`,
+		map[string]any{"input": map[string]any{"id": service["id"], "ownerInput": map[string]any{}}},
+	)
+	if owner := data["serviceUpdate"].(map[string]any)["service"].(map[string]any)["owner"]; owner != nil {
+		t.Errorf("expected an empty identifier to unlink the owner, got %v", owner)
+	}
+}
+
+func TestServerChecks(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	categoryId := server.Seed("category", map[string]any{"name": "Security"})
+	data, errs := post(t, server,
+		`mutation CheckManualCreate($input:CheckManualCreateInput!){checkManualCreate(input: $input){check{id,name,category{id},... on ManualCheck{updateRequiresComment}},errors{message}}}`,
+		map[string]any{"input": map[string]any{"name": "Runbook", "categoryId": categoryId, "updateRequiresComment": true}},
+	)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+	check := data["checkManualCreate"].(map[string]any)["check"].(map[string]any)
+	id := check["id"].(string)
+	if check["updateRequiresComment"] != true || check["category"].(map[string]any)["id"] != categoryId {
+		t.Errorf("expected the fields of a manual check, got %v", check)
+	}
+
+	get := `query CheckGet($id:ID!){account{check(id: $id){name,... on ManualCheck{updateRequiresComment},... on RepositoryFileCheck{filePaths}}}}`
+	data, _ = post(t, server, get, map[string]any{"id": id})
+	expected := map[string]any{"name": "Runbook", "updateRequiresComment": true}
+	if got := data["account"].(map[string]any)["check"]; !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected to read back the check as a ManualCheck %v, got %v", expected, got)
+	}
+
+	data, _ = post(t, server,
+		`mutation CheckManualUpdate($input:CheckManualUpdateInput!){checkManualUpdate(input: $input){check{name},errors{message}}}`,
+		map[string]any{"input": map[string]any{"id": id, "name": "Runbook reviewed"}},
+	)
+	if got := data["checkManualUpdate"].(map[string]any)["check"].(map[string]any)["name"]; got != "Runbook reviewed" {
+		t.Errorf("expected the updated name, got %v", got)
+	}
+
+	data, _ = post(t, server,
+		`mutation CheckDelete($input:CheckDeleteInput!){checkDelete(input: $input){deletedCheckId,errors{message}}}`,
+		map[string]any{"input": map[string]any{"id": id}},
+	)
+	if got := data["checkDelete"].(map[string]any)["deletedCheckId"]; got != id || server.Object("check", id) != nil {
+		t.Errorf("expected the check to be deleted, got %v", got)
+	}
+}
@@ -0,0 +1,375 @@
+package opslevel
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/opslevel/terraform-provider-opslevel/internal/fakeopslevel"
+)
+
+// testAccProtoV6ProviderFactories serve the provider from the test process, so Terraform runs its real CRUD code
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"opslevel": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// newFakeAPI starts a fake OpsLevel API for a single test, so no account, token or network access is needed
+func newFakeAPI(t *testing.T) *fakeopslevel.Server {
+	api := fakeopslevel.NewServer()
+	t.Cleanup(api.Close)
+	return api
+}
+
+// providerConfig points the provider at api, resources of the test are appended to it
+func providerConfig(api *fakeopslevel.Server, resources string) string {
+	return fmt.Sprintf(`
+provider "opslevel" {
+  api_token = "fake-token"
+  api_url   = %q
+}
+%s`, api.URL(), resources)
+}
+
+// stateId returns the id in state of the resource at address
+func stateId(state *terraform.State, address string) (string, error) {
+	resourceState, ok := state.RootModule().Resources[address]
+	if !ok {
+		return "", fmt.Errorf("%s is not in state", address)
+	}
+	return resourceState.Primary.ID, nil
+}
+
+// captureId stores the id of the resource at address for later steps that change its object behind Terraform's back
+func captureId(address string, id *string) resource.TestCheckFunc {
+	return func(state *terraform.State) (err error) {
+		*id, err = stateId(state, address)
+		return err
+	}
+}
+
+// checkObject checks a field of the object the fake API holds for the resource at address
+func checkObject(api *fakeopslevel.Server, kind string, address string, field string, expected any) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		id, err := stateId(state, address)
+		if err != nil {
+			return err
+		}
+		object := api.Object(kind, id)
+		if object == nil {
+			return fmt.Errorf("the fake API has no %s with the id '%s' of %s", kind, id, address)
+		}
+		if fmt.Sprint(object[field]) != fmt.Sprint(expected) {
+			return fmt.Errorf("expected %s of %s to be '%v', got '%v'", field, address, expected, object[field])
+		}
+		return nil
+	}
+}
+
+// checkLinked checks that the object the fake API holds for the resource at address links the object with linkedId
+func checkLinked(api *fakeopslevel.Server, kind string, address string, field string, linkedId string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		id, err := stateId(state, address)
+		if err != nil {
+			return err
+		}
+		linked, _ := api.Object(kind, id)[field].(map[string]any)
+		if linked["id"] != linkedId {
+			return fmt.Errorf("expected %s of %s to link '%s', got %v", field, address, linkedId, linked)
+		}
+		return nil
+	}
+}
+
+// importStep imports the resource at address by the id in state and checks that it reads back exactly as applied
+func importStep(address string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      address,
+		ImportState:       true,
+		ImportStateVerify: true,
+	}
+}
+
+// deletedOutsideOfTerraformStep removes the object of the resource at address from the fake API before the step,
+// as if it was deleted in the OpsLevel UI, and expects the refresh to drop it from state and the apply to create it again
+func deletedOutsideOfTerraformStep(t *testing.T, api *fakeopslevel.Server, kind string, address string, id *string, config string) resource.TestStep {
+	return resource.TestStep{
+		PreConfig: func() {
+			if !api.Remove(kind, *id) {
+				t.Fatalf("expected the fake API to hold %s '%s' of %s", kind, *id, address)
+			}
+		},
+		Config: config,
+		ConfigPlanChecks: resource.ConfigPlanChecks{
+			PreApply: []plancheck.PlanCheck{
+				plancheck.ExpectResourceAction(address, plancheck.ResourceActionCreate),
+			},
+		},
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(state *terraform.State) error {
+				recreatedId, err := stateId(state, address)
+				if err == nil && recreatedId == *id {
+					err = fmt.Errorf("expected %s to be created again with a new id, still got '%s'", address, *id)
+				}
+				return err
+			},
+			func(state *terraform.State) error {
+				recreatedId, _ := stateId(state, address)
+				if api.Object(kind, recreatedId) == nil {
+					return fmt.Errorf("expected the fake API to hold the recreated %s '%s'", kind, recreatedId)
+				}
+				return nil
+			},
+		),
+	}
+}
+
+func TestAcceptanceTeam(t *testing.T) {
+	api := newFakeAPI(t)
+	parentId := api.Seed("team", map[string]any{"name": "Engineering"})
+	create := providerConfig(api, `
+resource "opslevel_team" "test" {
+  name             = "Platform"
+  responsibilities = "Runs the platform"
+  parent           = "engineering"
+}
+`)
+	update := providerConfig(api, `
+resource "opslevel_team" "test" {
+  name             = "Platform Engineering"
+  responsibilities = "Runs the platform"
+}
+`)
+
+	var id string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: create,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opslevel_team.test", tfjsonpath.New("name"), knownvalue.StringExact("Platform")),
+					statecheck.ExpectKnownValue("opslevel_team.test", tfjsonpath.New("parent"), knownvalue.StringExact("engineering")),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					captureId("opslevel_team.test", &id),
+					checkObject(api, "team", "opslevel_team.test", "responsibilities", "Runs the platform"),
+					checkLinked(api, "team", "opslevel_team.test", "parentTeam", parentId),
+				),
+			},
+			importStep("opslevel_team.test"),
+			{
+				ResourceName:      "opslevel_team.test",
+				ImportState:       true,
+				ImportStateId:     "platform",
+				ImportStateVerify: true,
+			},
+			{
+				Config: update,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("opslevel_team.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("opslevel_team.test", "id", &id),
+					checkObject(api, "team", "opslevel_team.test", "name", "Platform Engineering"),
+				),
+			},
+			deletedOutsideOfTerraformStep(t, api, "team", "opslevel_team.test", &id, update),
+		},
+	})
+}
+
+// TestAcceptanceTeamIdentity checks the identity stored next to the state and imports by it instead of by the id
+func TestAcceptanceTeamIdentity(t *testing.T) {
+	api := newFakeAPI(t)
+	config := providerConfig(api, `
+resource "opslevel_team" "test" {
+  name = "Platform"
+}
+`)
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("opslevel_team.test", map[string]knownvalue.Check{
+						"id": knownvalue.NotNull(),
+					}),
+					statecheck.ExpectIdentityValueMatchesState("opslevel_team.test", tfjsonpath.New("id")),
+				},
+			},
+			{
+				ResourceName:    "opslevel_team.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAcceptanceService(t *testing.T) {
+	api := newFakeAPI(t)
+	platformId := api.Seed("team", map[string]any{"name": "Platform"})
+	create := providerConfig(api, fmt.Sprintf(`
+resource "opslevel_service" "test" {
+  name        = "Checkout"
+  description = "Takes payments"
+  language    = "Go"
+  owner       = %q
+}
+`, platformId))
+	update := providerConfig(api, `
+resource "opslevel_service" "test" {
+  name        = "Checkout"
+  description = "Takes payments and issues refunds"
+  language    = "Go"
+}
+`)
+
+	var id string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: create,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opslevel_service.test", tfjsonpath.New("owner"), knownvalue.StringExact(platformId)),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					captureId("opslevel_service.test", &id),
+					checkObject(api, "service", "opslevel_service.test", "description", "Takes payments"),
+					checkLinked(api, "service", "opslevel_service.test", "owner", platformId),
+				),
+			},
+			importStep("opslevel_service.test"),
+			{
+				Config: update,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opslevel_service.test", tfjsonpath.New("owner"), knownvalue.Null()),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("opslevel_service.test", "id", &id),
+					checkObject(api, "service", "opslevel_service.test", "description", "Takes payments and issues refunds"),
+					checkObject(api, "service", "opslevel_service.test", "owner", nil),
+				),
+			},
+			deletedOutsideOfTerraformStep(t, api, "service", "opslevel_service.test", &id, update),
+		},
+	})
+}
+
+func TestAcceptanceCheckManual(t *testing.T) {
+	api := newFakeAPI(t)
+	categoryId := api.Seed("category", map[string]any{"name": "Reliability"})
+	levelId := api.Seed("level", map[string]any{"name": "Bronze", "index": 1})
+	teamId := api.Seed("team", map[string]any{"name": "Platform"})
+	config := func(name string) string {
+		return providerConfig(api, fmt.Sprintf(`
+resource "opslevel_check_manual" "test" {
+  name                    = %q
+  category                = %q
+  level                   = %q
+  owner                   = %q
+  update_requires_comment = true
+}
+`, name, categoryId, levelId, teamId))
+	}
+
+	var id string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("Runbook reviewed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					captureId("opslevel_check_manual.test", &id),
+					resource.TestCheckResourceAttr("opslevel_check_manual.test", "enabled", "false"),
+					checkObject(api, "check", "opslevel_check_manual.test", "__typename", "ManualCheck"),
+					checkLinked(api, "check", "opslevel_check_manual.test", "category", categoryId),
+					checkLinked(api, "check", "opslevel_check_manual.test", "level", levelId),
+					checkLinked(api, "check", "opslevel_check_manual.test", "owner", teamId),
+				),
+			},
+			importStep("opslevel_check_manual.test"),
+			{
+				Config: config("Runbook reviewed this quarter"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("opslevel_check_manual.test", "id", &id),
+					checkObject(api, "check", "opslevel_check_manual.test", "name", "Runbook reviewed this quarter"),
+				),
+			},
+			deletedOutsideOfTerraformStep(t, api, "check", "opslevel_check_manual.test", &id, config("Runbook reviewed this quarter")),
+		},
+	})
+}
+
+func TestAcceptanceRubricAndDomain(t *testing.T) {
+	api := newFakeAPI(t)
+	platformId := api.Seed("team", map[string]any{"name": "Platform"})
+	create := providerConfig(api, fmt.Sprintf(`
+resource "opslevel_rubric_category" "test" {
+  name = "Security"
+}
+
+resource "opslevel_rubric_level" "test" {
+  name = "Bronze"
+}
+
+resource "opslevel_domain" "test" {
+  name  = "Payments"
+  owner = %q
+}
+`, platformId))
+	update := providerConfig(api, `
+resource "opslevel_rubric_category" "test" {
+  name = "Reliability"
+}
+
+resource "opslevel_rubric_level" "test" {
+  name        = "Bronze"
+  description = "The first level"
+}
+
+resource "opslevel_domain" "test" {
+  name = "Payments"
+  note = "Handles card data"
+}
+`)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: create,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkObject(api, "category", "opslevel_rubric_category.test", "name", "Security"),
+					checkObject(api, "level", "opslevel_rubric_level.test", "name", "Bronze"),
+					checkLinked(api, "domain", "opslevel_domain.test", "owner", platformId),
+				),
+			},
+			{
+				Config: update,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkObject(api, "category", "opslevel_rubric_category.test", "name", "Reliability"),
+					checkObject(api, "level", "opslevel_rubric_level.test", "description", "The first level"),
+					checkObject(api, "domain", "opslevel_domain.test", "note", "Handles card data"),
+					checkObject(api, "domain", "opslevel_domain.test", "owner", nil),
+				),
+			},
+		},
+	})
+}
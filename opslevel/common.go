@@ -11,10 +11,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/opslevel/opslevel-go/v2026"
 )
 
@@ -22,8 +26,11 @@ const providerIssueUrl = "https://github.com/OpsLevel/terraform-provider-opsleve
 
 const rateLimitGuidance = `
 API rate limit exceeded. Your OpsLevel API token is limited to 400 requests per minute.
+The request was retried but the rate limit was still exceeded.
 
 Possible solutions:
+  • Lower 'max_requests_per_minute' in the provider configuration (default is 400)
+  • Raise 'max_retries' or 'retry_max_wait' in the provider configuration
   • Reduce parallelism (default is 10): terraform apply -parallelism=1
   • Ensure the OpsLevel API token being used for Terraform is not being used elsewhere
   • Use saved plans to avoid double refreshes: terraform plan -out=plan && terraform apply plan
@@ -32,8 +39,29 @@ Possible solutions:
 For more information on rate limits, see: https://docs.opslevel.com/docs/graphql#what-is-the-api-rate-limit
 `
 
+// providerData is built once by OpslevelProvider.Configure and shared by every resource and data source
+type providerData struct {
+	// newClient builds a client that logs with the fields of ctx and whose requests are cancelled with ctx
+	newClient          func(ctx context.Context) *opslevel.Client
+	cache              *lookupCache
+	tags               *tagConfig
+	readOnly           bool
+	validateReferences bool
+}
+
+// Client returns an OpsLevel client whose GraphQL requests are logged with the fields of ctx.
+// Every RPC configures a new resource or data source, so the logs name the resource type and request.
+func (p *providerData) Client(ctx context.Context) *opslevel.Client {
+	return p.newClient(ctx)
+}
+
 type CommonResourceClient struct {
-	client *opslevel.Client
+	client             *opslevel.Client
+	newClient          func(ctx context.Context) *opslevel.Client
+	cache              *lookupCache
+	tags               *tagConfig
+	readOnly           bool
+	validateReferences bool
 }
 
 // Configure sets up the OpsLevel client for datasources and resources
@@ -43,43 +71,155 @@ func (d *CommonResourceClient) Configure(ctx context.Context, req resource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*opslevel.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("expected *opslevel.Client, got: %T please report this issue to the provider developers at %s.", req.ProviderData, providerIssueUrl),
+			fmt.Sprintf("expected *opslevel.providerData, got: %T please report this issue to the provider developers at %s.", req.ProviderData, providerIssueUrl),
 		)
 
 		return
 	}
 
-	d.client = client
+	d.client = data.Client(ctx)
+	d.newClient = data.newClient
+	d.cache = data.cache
+	d.tags = data.tags
+	d.readOnly = data.readOnly
+	d.validateReferences = data.validateReferences
+}
+
+// failIfReadOnly adds an error and returns true when the provider is in read_only mode.
+// Every Create, Update and Delete calls it before sending any mutation.
+func (d *CommonResourceClient) failIfReadOnly(diags *diag.Diagnostics, operation string) bool {
+	if !d.readOnly {
+		return false
+	}
+	diags.AddError(
+		"Provider is read only",
+		fmt.Sprintf("Unable to %s the resource because the OpsLevel provider is configured with 'read_only' (or OPSLEVEL_READ_ONLY). Reads and data sources still work, but no changes are sent to OpsLevel.", operation),
+	)
+	return true
+}
+
+// notFoundErrors are the fragments of errors returned when an object no longer exists
+var notFoundErrors = []string{
+	"not found",
+	"does not exist",
+	"is not a valid id",
+	"could not find",
+	"couldn't find",
+}
+
+// isNotFound reports whether a read failed because the object was deleted outside of Terraform.
+// found is whether the API returned the object, some queries answer with an empty object and no error.
+func isNotFound(err error, found bool) bool {
+	if err == nil {
+		return !found
+	}
+	if found || isRateLimitError(err) {
+		return false
+	}
+	if opslevel.IsOpsLevelApiError(err) {
+		return true
+	}
+	message := strings.ToLower(err.Error())
+	for _, fragment := range notFoundErrors {
+		if strings.Contains(message, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// removeIfNotFound removes a resource that was deleted outside of Terraform from state, so the next plan re-creates it
+// instead of failing until someone runs `terraform state rm`
+func removeIfNotFound(ctx context.Context, resp *resource.ReadResponse, err error, found bool) bool {
+	if !isNotFound(err, found) {
+		return false
+	}
+	fields := map[string]any{}
+	if err != nil {
+		fields["error"] = err.Error()
+	}
+	tflog.Warn(ctx, "resource no longer exists in OpsLevel, removing it from state", fields)
+	resp.State.RemoveResource(ctx)
+	return true
+}
+
+// idIdentitySchema is the resource identity of resources that can be found by their OpsLevel ID alone
+var idIdentitySchema = identityschema.Schema{
+	Attributes: map[string]identityschema.Attribute{
+		"id": identityschema.StringAttribute{
+			Description:       "The ID of the resource.",
+			RequiredForImport: true,
+		},
+	},
+}
+
+type idIdentityModel struct {
+	Id types.String `tfsdk:"id"`
+}
+
+// identifierValue returns whichever of an id or alias attribute pair is set, for identities that accept both
+func identifierValue(id, alias types.String) types.String {
+	if id.IsNull() || id.ValueString() == "" {
+		return alias
+	}
+	return id
 }
 
 type CommonDataSourceClient struct {
 	client *opslevel.Client
+	cache  *lookupCache
 }
 
 // Configure sets up the OpsLevel client for datasources and resources
-func (d *CommonDataSourceClient) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+func (d *CommonDataSourceClient) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*opslevel.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("expected *opslevel.Client, got: %T please report this issue to the provider developers at %s.", req.ProviderData, providerIssueUrl),
+			fmt.Sprintf("expected *opslevel.providerData, got: %T please report this issue to the provider developers at %s.", req.ProviderData, providerIssueUrl),
+		)
+
+		return
+	}
+
+	d.client = data.Client(ctx)
+	d.cache = data.cache
+}
+
+type CommonEphemeralResourceClient struct {
+	client *opslevel.Client
+}
+
+// Configure sets up the OpsLevel client for ephemeral resources
+func (e *CommonEphemeralResourceClient) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("expected *opslevel.providerData, got: %T please report this issue to the provider developers at %s.", req.ProviderData, providerIssueUrl),
 		)
 
 		return
 	}
 
-	d.client = client
+	e.client = data.Client(ctx)
 }
 
 func timeID() string {
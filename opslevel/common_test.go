@@ -0,0 +1,32 @@
+package opslevel
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIsNotFound(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		found    bool
+		expected bool
+	}{
+		{name: "found", err: nil, found: true, expected: false},
+		{name: "empty object", err: nil, found: false, expected: true},
+		{name: "does not exist", err: errors.New("Service with id 'Z2lkOi8vb3BzbGV2ZWwvU2VydmljZS8w' does not exist or you do not have permission to view it"), found: false, expected: true},
+		{name: "service not found", err: errors.New("service my-service not found"), found: false, expected: true},
+		{name: "invalid id", err: errors.New("'XXX' is not a valid ID"), found: false, expected: true},
+		{name: "rate limited", err: errors.New("429 Too Many Requests"), found: false, expected: false},
+		{name: "network error", err: errors.New("dial tcp: connection refused"), found: false, expected: false},
+		{name: "error with object", err: errors.New("does not exist"), found: true, expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := isNotFound(testCase.err, testCase.found); result != testCase.expected {
+				t.Errorf("expected isNotFound to be %v, got %v", testCase.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package opslevel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+// accountName returns the name of the account the token belongs to, or an empty string when it can't be read
+func accountName(client *opslevel.Client) string {
+	var q struct {
+		Account struct {
+			Name string
+		}
+	}
+	if err := client.Query(&q, nil); err != nil {
+		return ""
+	}
+	return q.Account.Name
+}
+
+// validateCredentials makes sure the token has at least the read scope of the account, only sending read only queries.
+// The API doesn't expose the scope of a token, so a read only token is only rejected by the first mutation of an apply.
+func validateCredentials(ctx context.Context, client *opslevel.Client, apiUrl string, tokenSource string, d *diag.Diagnostics) {
+	if err := client.Validate(); err != nil {
+		d.AddError(
+			"Invalid OpsLevel credentials",
+			fmt.Sprintf(
+				"Unable to authenticate with the OpsLevel account at '%s' using the API token from %s, got error: %s\n\n"+
+					"The provider needs a token with at least the read scope of the account, which every API token has. "+
+					"Check that the token is an API token of this account, that it has not been revoked, "+
+					"and that 'api_url' points at the right OpsLevel instance. "+
+					"Set 'skip_credentials_validation' to skip this check, for example when running against a mock API.",
+				apiUrl, tokenSource, err,
+			),
+		)
+		return
+	}
+
+	if name := accountName(client); name != "" {
+		tflog.Info(ctx, fmt.Sprintf("Authenticated with the OpsLevel account '%s' at '%s' using the API token from %s", name, apiUrl, tokenSource))
+	}
+}
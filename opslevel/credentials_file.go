@@ -0,0 +1,141 @@
+package opslevel
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const defaultProfile = "default"
+
+// credentialsProfile is one section of a shared credentials file, empty values fall through to the next source
+type credentialsProfile struct {
+	ApiToken   string
+	ApiUrl     string
+	ApiTimeout int64
+}
+
+// defaultSharedCredentialsFile returns ~/.opslevel/credentials, or an empty string if there is no home directory
+func defaultSharedCredentialsFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".opslevel", "credentials")
+}
+
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
+
+// readTokenFile returns the API token stored in a file, ignoring surrounding whitespace such as a trailing newline
+func readTokenFile(path string) (string, error) {
+	content, err := os.ReadFile(expandHome(path))
+	if err != nil {
+		return "", err
+	}
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", fmt.Errorf("file '%s' is empty", path)
+	}
+	return token, nil
+}
+
+// readCredentialsProfile reads a single profile from a shared credentials file
+func readCredentialsProfile(path string, profile string) (*credentialsProfile, error) {
+	file, err := os.Open(expandHome(path))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	profiles, err := parseCredentialsFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse '%s': %w", path, err)
+	}
+	values, ok := profiles[profile]
+	if !ok {
+		return nil, fmt.Errorf("profile '%s' not found in '%s'", profile, path)
+	}
+
+	output := &credentialsProfile{
+		ApiToken: values["api_token"],
+		ApiUrl:   values["api_url"],
+	}
+	if timeout, ok := values["api_timeout"]; ok {
+		output.ApiTimeout, err = strconv.ParseInt(timeout, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("profile '%s' in '%s' has an api_timeout that is not an int: '%s'", profile, path, timeout)
+		}
+	}
+	return output, nil
+}
+
+// parseCredentialsFile parses the flat subset of INI and TOML used by credentials files:
+//
+//	[sandbox]
+//	api_token   = "XXX"
+//	api_url     = "https://self-hosted.example.com/"
+//	api_timeout = 60
+//
+// Lines starting with '#' or ';' are comments, values may be wrapped in single or double quotes.
+func parseCredentialsFile(reader io.Reader) (map[string]map[string]string, error) {
+	profiles := map[string]map[string]string{}
+	var current map[string]string
+
+	scanner := bufio.NewScanner(reader)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("line %d: unterminated profile header", lineNumber)
+			}
+			name := unquoteCredentialValue(strings.TrimSpace(line[1 : len(line)-1]))
+			if name == "" {
+				return nil, fmt.Errorf("line %d: empty profile name", lineNumber)
+			}
+			if _, ok := profiles[name]; !ok {
+				profiles[name] = map[string]string{}
+			}
+			current = profiles[name]
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected 'key = value'", lineNumber)
+		}
+		if current == nil {
+			return nil, fmt.Errorf("line %d: '%s' is set outside of a profile", lineNumber, strings.TrimSpace(key))
+		}
+		current[strings.TrimSpace(key)] = unquoteCredentialValue(strings.TrimSpace(value))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+func unquoteCredentialValue(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
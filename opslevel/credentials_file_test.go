@@ -0,0 +1,160 @@
+package opslevel
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseCredentialsFile(t *testing.T) {
+	content := `
+# OpsLevel accounts
+[default]
+api_token = "default-token"
+
+[sandbox]
+api_token   = 'sandbox-token'
+api_url     = "https://sandbox.opslevel.example.com/"
+api_timeout = 60
+; acquired company
+["acquired"]
+api_token = acquired-token
+`
+	profiles, err := parseCredentialsFile(strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		profile  string
+		key      string
+		expected string
+	}{
+		{profile: "default", key: "api_token", expected: "default-token"},
+		{profile: "sandbox", key: "api_token", expected: "sandbox-token"},
+		{profile: "sandbox", key: "api_url", expected: "https://sandbox.opslevel.example.com/"},
+		{profile: "sandbox", key: "api_timeout", expected: "60"},
+		{profile: "acquired", key: "api_token", expected: "acquired-token"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.profile+"/"+testCase.key, func(t *testing.T) {
+			if value := profiles[testCase.profile][testCase.key]; value != testCase.expected {
+				t.Errorf("expected '%s', got '%s'", testCase.expected, value)
+			}
+		})
+	}
+}
+
+func TestParseCredentialsFileErrors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		content string
+	}{
+		{name: "value outside of a profile", content: "api_token = token"},
+		{name: "unterminated profile header", content: "[default"},
+		{name: "empty profile name", content: "[]"},
+		{name: "missing value", content: "[default]\napi_token"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if _, err := parseCredentialsFile(strings.NewReader(testCase.content)); err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
+func TestReadCredentialsProfile(t *testing.T) {
+	credentialsFile := filepath.Join(t.TempDir(), "credentials")
+	content := "[sandbox]\napi_token = \"sandbox-token\"\napi_timeout = 60\n"
+	if err := os.WriteFile(credentialsFile, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	profile, err := readCredentialsProfile(credentialsFile, "sandbox")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if profile.ApiToken != "sandbox-token" || profile.ApiTimeout != 60 || profile.ApiUrl != "" {
+		t.Errorf("unexpected profile %+v", profile)
+	}
+
+	if _, err := readCredentialsProfile(credentialsFile, "production"); err == nil {
+		t.Errorf("expected an error for a missing profile")
+	}
+}
+
+func TestConfigCredentialsPrecedence(t *testing.T) {
+	profile := &credentialsProfile{ApiToken: "sandbox-token", ApiUrl: "https://sandbox.opslevel.example.com/"}
+
+	testCases := []struct {
+		name          string
+		data          OpslevelProviderModel
+		profile       *credentialsProfile
+		expectedToken string
+		expectedUrl   string
+		expectError   bool
+	}{
+		{
+			name:          "environment wins over a profile selected by the environment",
+			profile:       profile,
+			expectedToken: "env-token",
+			expectedUrl:   "https://env.opslevel.example.com/",
+		},
+		{
+			name:          "profile in the provider block wins over the environment",
+			data:          OpslevelProviderModel{Profile: types.StringValue("sandbox")},
+			profile:       profile,
+			expectedToken: "sandbox-token",
+			expectedUrl:   "https://sandbox.opslevel.example.com/",
+		},
+		{
+			name:          "shared credentials file in the provider block wins over the environment",
+			data:          OpslevelProviderModel{SharedCredentialsFile: types.StringValue("credentials")},
+			profile:       &credentialsProfile{ApiToken: "sandbox-token"},
+			expectedToken: "sandbox-token",
+			expectedUrl:   "https://api.opslevel.com/",
+		},
+		{
+			name:          "api_token and api_url win over the profile",
+			data:          OpslevelProviderModel{Profile: types.StringValue("sandbox"), ApiToken: types.StringValue("config-token"), ApiUrl: types.StringValue("https://config.opslevel.example.com/")},
+			profile:       profile,
+			expectedToken: "config-token",
+			expectedUrl:   "https://config.opslevel.example.com/",
+		},
+		{
+			name:        "profile in the provider block without a token",
+			data:        OpslevelProviderModel{Profile: types.StringValue("sandbox")},
+			profile:     &credentialsProfile{ApiUrl: "https://sandbox.opslevel.example.com/"},
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Setenv("OPSLEVEL_API_TOKEN", "env-token")
+			t.Setenv("OPSLEVEL_API_URL", "https://env.opslevel.example.com/")
+			data := testCase.data
+			resp := &provider.ConfigureResponse{}
+
+			configApiToken(&data, testCase.profile, resp)
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Fatalf("expected an error to be %v, got diagnostics %v", testCase.expectError, resp.Diagnostics)
+			}
+			if testCase.expectError {
+				return
+			}
+			configApiUrl(&data, testCase.profile)
+			if data.ApiToken.ValueString() != testCase.expectedToken {
+				t.Errorf("expected token '%s', got '%s'", testCase.expectedToken, data.ApiToken.ValueString())
+			}
+			if data.ApiUrl.ValueString() != testCase.expectedUrl {
+				t.Errorf("expected url '%s', got '%s'", testCase.expectedUrl, data.ApiUrl.ValueString())
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package opslevel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/opslevel/opslevel-go/v2026"
+	"github.com/opslevel/terraform-provider-opslevel/internal/fakeopslevel"
+)
+
+func TestValidateCredentialsOnlyReads(t *testing.T) {
+	api := fakeopslevel.NewServer()
+	defer api.Close()
+	client := opslevel.NewGQLClient(opslevel.SetAPIToken("fake-token"), opslevel.SetURL(api.URL()), opslevel.SetMaxRetries(0))
+
+	var diags diag.Diagnostics
+	validateCredentials(context.Background(), client, api.URL(), "'api_token'", &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	for _, operation := range api.Operations() {
+		if !strings.HasPrefix(operation, "account.") {
+			t.Errorf("expected validation to only query the account, got '%s'", operation)
+		}
+	}
+	if name := accountName(client); name != fakeopslevel.AccountName {
+		t.Errorf("expected account name '%s', got '%s'", fakeopslevel.AccountName, name)
+	}
+}
+
+func TestValidateCredentialsNamesTokenSource(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+	}))
+	defer api.Close()
+	client := opslevel.NewGQLClient(opslevel.SetAPIToken("revoked-token"), opslevel.SetURL(api.URL), opslevel.SetMaxRetries(0))
+
+	var diags diag.Diagnostics
+	validateCredentials(context.Background(), client, api.URL, "OPSLEVEL_API_TOKEN", &diags)
+	if !diags.HasError() {
+		t.Fatal("expected an error for a rejected token")
+	}
+	if detail := diags[0].Detail(); !strings.Contains(detail, "OPSLEVEL_API_TOKEN") || !strings.Contains(detail, api.URL) || !strings.Contains(detail, "read scope") {
+		t.Errorf("expected the error to name the token source, url and scope, got: %s", detail)
+	}
+}
@@ -0,0 +1,178 @@
+package opslevel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+// Ensure AliasLookupDataSource implements DataSourceWithConfigure interface
+var _ datasource.DataSourceWithConfigure = &AliasLookupDataSource{}
+
+func NewAliasLookupDataSource() datasource.DataSource {
+	return &AliasLookupDataSource{}
+}
+
+// AliasLookupDataSource manages an Alias Lookup data source.
+type AliasLookupDataSource struct {
+	CommonDataSourceClient
+}
+
+// aliasLookupOwnerTypes are searched in order when no owner_type is given, the deprecated group type is left out
+var aliasLookupOwnerTypes = []opslevel.AliasOwnerTypeEnum{
+	opslevel.AliasOwnerTypeEnumService,
+	opslevel.AliasOwnerTypeEnumTeam,
+	opslevel.AliasOwnerTypeEnumSystem,
+	opslevel.AliasOwnerTypeEnumDomain,
+	opslevel.AliasOwnerTypeEnumInfrastructureResource,
+	opslevel.AliasOwnerTypeEnumScorecard,
+}
+
+// aliasLookupDataSourceModel describes the data source data model.
+type aliasLookupDataSourceModel struct {
+	Alias        types.String `tfsdk:"alias"`
+	Aliases      types.List   `tfsdk:"aliases"`
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	OwnerType    types.String `tfsdk:"owner_type"`
+	ResourceType types.String `tfsdk:"resource_type"`
+}
+
+// aliasableName returns the name of the resources GetAliasableResource can return
+func aliasableName(aliasable opslevel.AliasableResourceInterface) string {
+	switch resource := aliasable.(type) {
+	case *opslevel.Service:
+		return resource.Name
+	case *opslevel.Team:
+		return resource.Name
+	case *opslevel.System:
+		return resource.Name
+	case *opslevel.Domain:
+		return resource.Name
+	case *opslevel.InfrastructureResource:
+		return resource.Name
+	case *opslevel.Scorecard:
+		return resource.Name
+	}
+	return ""
+}
+
+// lookupAlias returns the resource of the owner type with the alias, or nil when there is none
+func lookupAlias(client *opslevel.Client, ownerType opslevel.AliasOwnerTypeEnum, alias string) (opslevel.AliasableResourceInterface, error) {
+	aliasable, err := client.GetAliasableResource(ownerType, alias)
+	found := err == nil && aliasable != nil && aliasable.ResourceId() != ""
+	if isNotFound(err, found) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return aliasable, nil
+}
+
+// resolveAlias returns the one resource of the owner types with the alias, it is an error for none or several to have it
+func resolveAlias(client *opslevel.Client, ownerTypes []opslevel.AliasOwnerTypeEnum, alias string, d *diag.Diagnostics) opslevel.AliasableResourceInterface {
+	var matches []opslevel.AliasableResourceInterface
+	for _, ownerType := range ownerTypes {
+		aliasable, err := lookupAlias(client, ownerType, alias)
+		if err != nil {
+			d.AddError("Client Error", fmt.Sprintf("Unable to find %s with alias '%s', got error: %s", ownerType, alias, err))
+			return nil
+		}
+		if aliasable != nil {
+			matches = append(matches, aliasable)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		d.AddError("Client Error", fmt.Sprintf("Unable to find a resource with alias '%s'", alias))
+		return nil
+	case 1:
+		return matches[0]
+	default:
+		resourceTypes := make([]string, len(matches))
+		for i, match := range matches {
+			resourceTypes[i] = string(match.AliasableType())
+		}
+		d.AddError("Ambiguous alias", fmt.Sprintf("Alias '%s' belongs to a %s, set 'owner_type' to choose one", alias, strings.Join(resourceTypes, " and a ")))
+		return nil
+	}
+}
+
+func (d *AliasLookupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alias_lookup"
+}
+
+func (d *AliasLookupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Alias Lookup data source",
+
+		Attributes: map[string]schema.Attribute{
+			"alias": schema.StringAttribute{
+				Description: "The alias to resolve.",
+				Required:    true,
+			},
+			"aliases": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "All the aliases of the resource the alias belongs to.",
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The id of the resource the alias belongs to.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the resource the alias belongs to.",
+				Computed:    true,
+			},
+			"owner_type": schema.StringAttribute{
+				Description: fmt.Sprintf(
+					"Only resolve the alias against resources of this type. One of `%s`. When unset, the alias must belong to exactly one resource.",
+					strings.Join(opslevel.AllAliasOwnerTypeEnum, "`, `"),
+				),
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.OneOf(opslevel.AllAliasOwnerTypeEnum...)},
+			},
+			"resource_type": schema.StringAttribute{
+				Description: "The type of the resource the alias belongs to.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *AliasLookupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	configModel := read[aliasLookupDataSourceModel](ctx, &resp.Diagnostics, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alias := configModel.Alias.ValueString()
+	ownerTypes := aliasLookupOwnerTypes
+	if ownerType := configModel.OwnerType.ValueString(); ownerType != "" {
+		ownerTypes = []opslevel.AliasOwnerTypeEnum{opslevel.AliasOwnerTypeEnum(ownerType)}
+	}
+
+	aliasable := resolveAlias(d.client, ownerTypes, alias, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateModel := configModel
+	stateModel.Aliases = OptionalStringListValue(aliasable.GetAliases())
+	stateModel.Id = ComputedStringValue(string(aliasable.ResourceId()))
+	stateModel.Name = OptionalStringValue(aliasableName(aliasable))
+	stateModel.ResourceType = ComputedStringValue(string(aliasable.AliasableType()))
+
+	tflog.Trace(ctx, "read an OpsLevel Alias Lookup data source")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+}
@@ -0,0 +1,52 @@
+package opslevel
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/opslevel/opslevel-go/v2026"
+	"github.com/opslevel/terraform-provider-opslevel/internal/fakeopslevel"
+)
+
+func TestResolveAlias(t *testing.T) {
+	api := fakeopslevel.NewServer()
+	defer api.Close()
+	client := opslevel.NewGQLClient(opslevel.SetAPIToken("fake-token"), opslevel.SetURL(api.URL()), opslevel.SetMaxRetries(0))
+	teamId := api.Seed("team", map[string]any{"name": "Platform"})
+	serviceId := api.Seed("service", map[string]any{"name": "Checkout"})
+	checkoutTeamId := api.Seed("team", map[string]any{"name": "Checkout"})
+
+	testCases := []struct {
+		name          string
+		alias         string
+		ownerTypes    []opslevel.AliasOwnerTypeEnum
+		expectedId    string
+		expectedError string
+	}{
+		{name: "one owner", alias: "platform", ownerTypes: aliasLookupOwnerTypes, expectedId: teamId},
+		{name: "unknown alias", alias: "missing", ownerTypes: aliasLookupOwnerTypes, expectedError: "Unable to find a resource with alias 'missing'"},
+		{name: "ambiguous alias", alias: "checkout", ownerTypes: aliasLookupOwnerTypes, expectedError: "Alias 'checkout' belongs to a service and a team, set 'owner_type' to choose one"},
+		{name: "ambiguous alias with service owner type", alias: "checkout", ownerTypes: []opslevel.AliasOwnerTypeEnum{opslevel.AliasOwnerTypeEnumService}, expectedId: serviceId},
+		{name: "ambiguous alias with team owner type", alias: "checkout", ownerTypes: []opslevel.AliasOwnerTypeEnum{opslevel.AliasOwnerTypeEnumTeam}, expectedId: checkoutTeamId},
+		{name: "alias of another owner type", alias: "platform", ownerTypes: []opslevel.AliasOwnerTypeEnum{opslevel.AliasOwnerTypeEnumService}, expectedError: "Unable to find a resource with alias 'platform'"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			aliasable := resolveAlias(client, tc.ownerTypes, tc.alias, &diags)
+			if tc.expectedError != "" {
+				if !diags.HasError() || diags.Errors()[0].Detail() != tc.expectedError {
+					t.Fatalf("expected the error '%s', got %v", tc.expectedError, diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if string(aliasable.ResourceId()) != tc.expectedId {
+				t.Errorf("expected '%s' to resolve to '%s', got '%s'", tc.alias, tc.expectedId, aliasable.ResourceId())
+			}
+		})
+	}
+}
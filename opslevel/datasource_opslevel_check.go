@@ -0,0 +1,521 @@
+package opslevel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+// Ensure CheckDataSource implements DataSourceWithConfigure interface
+var _ datasource.DataSourceWithConfigure = &CheckDataSource{}
+
+func NewCheckDataSource() datasource.DataSource {
+	return &CheckDataSource{}
+}
+
+// CheckDataSource manages a Check data source.
+type CheckDataSource struct {
+	CommonDataSourceClient
+}
+
+func checkPredicateDataSourceAttr(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "A condition that should be satisfied.",
+				Computed:    true,
+			},
+			"value": schema.StringAttribute{
+				Description: "The condition value used by the predicate.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+var updateFrequencyType = map[string]attr.Type{
+	"time_scale": types.StringType,
+	"value":      types.Int64Type,
+}
+
+// checkConfigSchemaAttrs holds the settings of every check type, only the ones used by the check's type are set
+var checkConfigSchemaAttrs = map[string]schema.Attribute{
+	"alert_name_predicate": checkPredicateDataSourceAttr("The alert name predicate of an alert source usage check."),
+	"alert_type": schema.StringAttribute{
+		Description: "The type of the alert source of an alert source usage check.",
+		Computed:    true,
+	},
+	"constraint": schema.StringAttribute{
+		Description: "The type of constraint used in evaluation the code issues check.",
+		Computed:    true,
+	},
+	"contact_method": schema.StringAttribute{
+		Description: "The type of contact method that a service ownership check requires.",
+		Computed:    true,
+	},
+	"days": schema.Int64Attribute{
+		Description: "The number of days a has recent deploy check looks back for a deploy.",
+		Computed:    true,
+	},
+	"directory_search": schema.BoolAttribute{
+		Description: "Whether a repository file or grep check searches for directories instead of files.",
+		Computed:    true,
+	},
+	"document_subtype": schema.StringAttribute{
+		Description: "The subtype of the document of a has documentation check.",
+		Computed:    true,
+	},
+	"document_type": schema.StringAttribute{
+		Description: "The type of the document of a has documentation check.",
+		Computed:    true,
+	},
+	"environment_predicate":   checkPredicateDataSourceAttr("The environment predicate of a tool usage check."),
+	"file_contents_predicate": checkPredicateDataSourceAttr("The file contents predicate of a repository file, grep or search check."),
+	"file_extensions": schema.ListAttribute{
+		Description: "The file extensions a repository search check restricts its search to.",
+		ElementType: types.StringType,
+		Computed:    true,
+	},
+	"filepaths": schema.ListAttribute{
+		Description: "The paths a repository file or grep check searches.",
+		ElementType: types.StringType,
+		Computed:    true,
+	},
+	"integration": schema.StringAttribute{
+		Description: "The integration id a custom event check receives events from.",
+		Computed:    true,
+	},
+	"issue_name": schema.StringAttribute{
+		Description: "The issue name used for code issue lookup.",
+		Computed:    true,
+	},
+	"issue_type": schema.ListAttribute{
+		Description: "The types of code issues to consider.",
+		ElementType: types.StringType,
+		Computed:    true,
+	},
+	"max_allowed": schema.Int64Attribute{
+		Description: "The threshold count of code issues beyond which the check starts failing.",
+		Computed:    true,
+	},
+	"message": schema.StringAttribute{
+		Description: "The check result message template of a custom event check.",
+		Computed:    true,
+	},
+	"missing_package_result": schema.StringAttribute{
+		Description: "The check result when the package of a package version check is missing.",
+		Computed:    true,
+	},
+	"package_constraint": schema.StringAttribute{
+		Description: "The package constraint of a package version check.",
+		Computed:    true,
+	},
+	"package_manager": schema.StringAttribute{
+		Description: "The package manager of a package version check.",
+		Computed:    true,
+	},
+	"package_name": schema.StringAttribute{
+		Description: "The package name of a package version check.",
+		Computed:    true,
+	},
+	"package_name_is_regex": schema.BoolAttribute{
+		Description: "Whether the package name of a package version check is a regex.",
+		Computed:    true,
+	},
+	"pass_pending": schema.BoolAttribute{
+		Description: "Whether a custom event check passes while no events have been received.",
+		Computed:    true,
+	},
+	"predicate": checkPredicateDataSourceAttr("The predicate of a service property check."),
+	"property": schema.StringAttribute{
+		Description: "The property of the service a service property check validates.",
+		Computed:    true,
+	},
+	"relationship_count_predicate": checkPredicateDataSourceAttr("The relationship count predicate of a relationship check."),
+	"relationship_definition_id": schema.StringAttribute{
+		Description: "The id of the relationship definition of a relationship check.",
+		Computed:    true,
+	},
+	"require_contact_method": schema.BoolAttribute{
+		Description: "Whether a service ownership check requires the owner to have a contact method.",
+		Computed:    true,
+	},
+	"resolution_time": schema.SingleNestedAttribute{
+		Description: "The resolution time of the code issues of a code issue check.",
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"unit": schema.StringAttribute{
+				Description: "The name of duration of time.",
+				Computed:    true,
+			},
+			"value": schema.Int64Attribute{
+				Description: "The amount of time.",
+				Computed:    true,
+			},
+		},
+	},
+	"service_selector": schema.StringAttribute{
+		Description: "A jq expression selecting the service of a custom event check's events.",
+		Computed:    true,
+	},
+	"severity": schema.ListAttribute{
+		Description: "The severity levels of the issue.",
+		ElementType: types.StringType,
+		Computed:    true,
+	},
+	"success_condition": schema.StringAttribute{
+		Description: "A jq expression deciding whether a custom event check passes.",
+		Computed:    true,
+	},
+	"tag_key": schema.StringAttribute{
+		Description: "The tag key of a tag defined or service ownership check.",
+		Computed:    true,
+	},
+	"tag_predicate": checkPredicateDataSourceAttr("The tag value predicate of a tag defined or service ownership check."),
+	"tool_category": schema.StringAttribute{
+		Description: "The category of the tool of a tool usage check.",
+		Computed:    true,
+	},
+	"tool_name_predicate": checkPredicateDataSourceAttr("The tool name predicate of a tool usage check."),
+	"tool_url_predicate":  checkPredicateDataSourceAttr("The tool url predicate of a tool usage check."),
+	"update_frequency": schema.SingleNestedAttribute{
+		Description: "The minimum frequency of the updates of a manual check.",
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"time_scale": schema.StringAttribute{
+				Description: "The time scale type for the frequency.",
+				Computed:    true,
+			},
+			"value": schema.Int64Attribute{
+				Description: "The value to be used together with the frequency time_scale.",
+				Computed:    true,
+			},
+		},
+	},
+	"update_requires_comment": schema.BoolAttribute{
+		Description: "Whether updating a manual check requires a comment.",
+		Computed:    true,
+	},
+	"use_absolute_root": schema.BoolAttribute{
+		Description: "Whether a repository file check searches from the absolute root of the repository.",
+		Computed:    true,
+	},
+	"version_constraint_predicate": checkPredicateDataSourceAttr("The version constraint predicate of a package version check."),
+}
+
+// checkConfigType is derived from the schema so that the two can't drift apart
+var checkConfigType = schema.SingleNestedAttribute{Attributes: checkConfigSchemaAttrs}.GetType().(types.ObjectType).AttrTypes
+
+var checkSchemaAttrs = map[string]schema.Attribute{
+	"category": schema.StringAttribute{
+		Description: "The id of the category the check belongs to.",
+		Computed:    true,
+	},
+	"config": schema.SingleNestedAttribute{
+		Description: "The settings specific to the type of the check. Settings of other check types are null.",
+		Computed:    true,
+		Attributes:  checkConfigSchemaAttrs,
+	},
+	"description": schema.StringAttribute{
+		Description: "The description the check.",
+		Computed:    true,
+	},
+	"enabled": schema.BoolAttribute{
+		Description: "Whether the check is enabled or not.",
+		Computed:    true,
+	},
+	"enable_on": schema.StringAttribute{
+		Description: "The date when the check will be automatically enabled.",
+		Computed:    true,
+	},
+	"filter": schema.StringAttribute{
+		Description: "The id of the filter of the check.",
+		Computed:    true,
+	},
+	"id": schema.StringAttribute{
+		Description: "The id of the check.",
+		Computed:    true,
+	},
+	"level": schema.StringAttribute{
+		Description: "The id of the level the check belongs to.",
+		Computed:    true,
+	},
+	"name": schema.StringAttribute{
+		Description: "The display name of the check.",
+		Computed:    true,
+	},
+	"notes": schema.StringAttribute{
+		Description: "Additional information to display to the service owner about the check.",
+		Computed:    true,
+	},
+	"owner": schema.StringAttribute{
+		Description: "The id of the team that owns the check.",
+		Computed:    true,
+	},
+	"type": schema.StringAttribute{
+		Description: "The type of the check.",
+		Computed:    true,
+	},
+}
+
+func CheckAttributes(attrs map[string]schema.Attribute) map[string]schema.Attribute {
+	for key, value := range checkSchemaAttrs {
+		attrs[key] = value
+	}
+	return attrs
+}
+
+type checkConfigModel struct {
+	AlertNamePredicate         types.Object `tfsdk:"alert_name_predicate"`
+	AlertType                  types.String `tfsdk:"alert_type"`
+	Constraint                 types.String `tfsdk:"constraint"`
+	ContactMethod              types.String `tfsdk:"contact_method"`
+	Days                       types.Int64  `tfsdk:"days"`
+	DirectorySearch            types.Bool   `tfsdk:"directory_search"`
+	DocumentSubtype            types.String `tfsdk:"document_subtype"`
+	DocumentType               types.String `tfsdk:"document_type"`
+	EnvironmentPredicate       types.Object `tfsdk:"environment_predicate"`
+	FileContentsPredicate      types.Object `tfsdk:"file_contents_predicate"`
+	FileExtensions             types.List   `tfsdk:"file_extensions"`
+	Filepaths                  types.List   `tfsdk:"filepaths"`
+	Integration                types.String `tfsdk:"integration"`
+	IssueName                  types.String `tfsdk:"issue_name"`
+	IssueType                  types.List   `tfsdk:"issue_type"`
+	MaxAllowed                 types.Int64  `tfsdk:"max_allowed"`
+	Message                    types.String `tfsdk:"message"`
+	MissingPackageResult       types.String `tfsdk:"missing_package_result"`
+	PackageConstraint          types.String `tfsdk:"package_constraint"`
+	PackageManager             types.String `tfsdk:"package_manager"`
+	PackageName                types.String `tfsdk:"package_name"`
+	PackageNameIsRegex         types.Bool   `tfsdk:"package_name_is_regex"`
+	PassPending                types.Bool   `tfsdk:"pass_pending"`
+	Predicate                  types.Object `tfsdk:"predicate"`
+	Property                   types.String `tfsdk:"property"`
+	RelationshipCountPredicate types.Object `tfsdk:"relationship_count_predicate"`
+	RelationshipDefinitionId   types.String `tfsdk:"relationship_definition_id"`
+	RequireContactMethod       types.Bool   `tfsdk:"require_contact_method"`
+	ResolutionTime             types.Object `tfsdk:"resolution_time"`
+	ServiceSelector            types.String `tfsdk:"service_selector"`
+	Severity                   types.List   `tfsdk:"severity"`
+	SuccessCondition           types.String `tfsdk:"success_condition"`
+	TagKey                     types.String `tfsdk:"tag_key"`
+	TagPredicate               types.Object `tfsdk:"tag_predicate"`
+	ToolCategory               types.String `tfsdk:"tool_category"`
+	ToolNamePredicate          types.Object `tfsdk:"tool_name_predicate"`
+	ToolUrlPredicate           types.Object `tfsdk:"tool_url_predicate"`
+	UpdateFrequency            types.Object `tfsdk:"update_frequency"`
+	UpdateRequiresComment      types.Bool   `tfsdk:"update_requires_comment"`
+	UseAbsoluteRoot            types.Bool   `tfsdk:"use_absolute_root"`
+	VersionConstraintPredicate types.Object `tfsdk:"version_constraint_predicate"`
+}
+
+// checkDataSourceModel describes the data source data model.
+type checkDataSourceModel struct {
+	Category    types.String `tfsdk:"category"`
+	Config      types.Object `tfsdk:"config"`
+	Description types.String `tfsdk:"description"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	EnableOn    types.String `tfsdk:"enable_on"`
+	Filter      types.String `tfsdk:"filter"`
+	Id          types.String `tfsdk:"id"`
+	Level       types.String `tfsdk:"level"`
+	Name        types.String `tfsdk:"name"`
+	Notes       types.String `tfsdk:"notes"`
+	Owner       types.String `tfsdk:"owner"`
+	Type        types.String `tfsdk:"type"`
+}
+
+type checkDataSourceWithIdentifierModel struct {
+	checkDataSourceModel
+	Identifier types.String `tfsdk:"identifier"`
+}
+
+// newCheckConfigModel reads the fragment matching the type of the check, the same way the check resources do
+func newCheckConfigModel(check opslevel.Check) checkConfigModel {
+	config := checkConfigModel{
+		AlertNamePredicate:         types.ObjectNull(predicateType),
+		EnvironmentPredicate:       types.ObjectNull(predicateType),
+		FileContentsPredicate:      types.ObjectNull(predicateType),
+		FileExtensions:             types.ListNull(types.StringType),
+		Filepaths:                  types.ListNull(types.StringType),
+		IssueType:                  types.ListNull(types.StringType),
+		Predicate:                  types.ObjectNull(predicateType),
+		RelationshipCountPredicate: types.ObjectNull(predicateType),
+		ResolutionTime:             types.ObjectNull(resolutionTimeType),
+		Severity:                   types.ListNull(types.StringType),
+		TagPredicate:               types.ObjectNull(predicateType),
+		ToolNamePredicate:          types.ObjectNull(predicateType),
+		ToolUrlPredicate:           types.ObjectNull(predicateType),
+		UpdateFrequency:            types.ObjectNull(updateFrequencyType),
+		VersionConstraintPredicate: types.ObjectNull(predicateType),
+	}
+
+	switch check.Type {
+	case opslevel.CheckTypeAlertSourceUsage:
+		config.AlertType = types.StringValue(string(check.AlertSourceType))
+		config.AlertNamePredicate = ParsePredicate(check.AlertSourceNamePredicate)
+	case opslevel.CheckTypeCodeIssue:
+		config.Constraint = RequiredStringValue(string(check.Constraint))
+		config.IssueName = OptionalStringValue(check.IssueName)
+		config.IssueType = OptionalStringListValue(check.IssueType)
+		// NOTE: API prevents MaxAllowed from being zero
+		if check.MaxAllowed > 0 {
+			config.MaxAllowed = types.Int64Value(int64(check.MaxAllowed))
+		}
+		if check.ResolutionTime != (opslevel.CodeIssueResolutionTime{}) {
+			config.ResolutionTime = types.ObjectValueMust(resolutionTimeType, map[string]attr.Value{
+				"unit":  types.StringValue(string(check.ResolutionTime.Unit)),
+				"value": types.Int64Value(int64(check.ResolutionTime.Value)),
+			})
+		}
+		config.Severity = OptionalStringListValue(check.Severity)
+	case opslevel.CheckTypeGeneric:
+		config.Integration = RequiredStringValue(string(check.CustomEventCheckFragment.Integration.Id))
+		config.Message = OptionalStringValue(check.CustomEventCheckFragment.ResultMessage)
+		config.PassPending = RequiredBoolValue(check.CustomEventCheckFragment.PassPending)
+		config.ServiceSelector = RequiredStringValue(check.CustomEventCheckFragment.ServiceSelector)
+		config.SuccessCondition = RequiredStringValue(check.CustomEventCheckFragment.SuccessCondition)
+	case opslevel.CheckTypeHasDocumentation:
+		config.DocumentSubtype = types.StringValue(string(check.DocumentSubtype))
+		config.DocumentType = types.StringValue(string(check.DocumentType))
+	case opslevel.CheckTypeHasOwner:
+		if check.ServiceOwnershipCheckFragment.ContactMethod != nil {
+			config.ContactMethod = OptionalStringValue(string(*check.ServiceOwnershipCheckFragment.ContactMethod))
+		}
+		config.RequireContactMethod = OptionalBoolValue(check.ServiceOwnershipCheckFragment.RequireContactMethod)
+		config.TagKey = OptionalStringValue(check.ServiceOwnershipCheckFragment.TeamTagKey)
+		config.TagPredicate = ParsePredicate(check.ServiceOwnershipCheckFragment.TeamTagPredicate)
+	case opslevel.CheckTypeHasRecentDeploy:
+		config.Days = types.Int64Value(int64(check.Days))
+	case opslevel.CheckTypeManual:
+		if check.UpdateFrequency != nil {
+			config.UpdateFrequency = types.ObjectValueMust(updateFrequencyType, map[string]attr.Value{
+				"time_scale": RequiredStringValue(string(check.UpdateFrequency.FrequencyTimeScale)),
+				"value":      RequiredIntValue(check.UpdateFrequency.FrequencyValue),
+			})
+		}
+		config.UpdateRequiresComment = RequiredBoolValue(check.UpdateRequiresComment)
+	case opslevel.CheckTypePackageVersion:
+		if check.MissingPackageResult != nil {
+			config.MissingPackageResult = OptionalStringValue(string(*check.MissingPackageResult))
+		}
+		config.PackageConstraint = RequiredStringValue(string(check.PackageConstraint))
+		config.PackageManager = RequiredStringValue(string(check.PackageManager))
+		config.PackageName = RequiredStringValue(check.PackageName)
+		config.PackageNameIsRegex = RequiredBoolValue(check.PackageNameIsRegex)
+		config.VersionConstraintPredicate = ParsePredicate(check.VersionConstraintPredicate)
+	case opslevel.CheckTypeRelationship:
+		config.RelationshipCountPredicate = ParsePredicate(check.RelationshipCheckFragment.RelationshipCountPredicate)
+		config.RelationshipDefinitionId = RequiredStringValue(string(check.RelationshipCheckFragment.RelationshipDefinition.Id))
+	case opslevel.CheckTypeRepoFile:
+		config.DirectorySearch = RequiredBoolValue(check.RepositoryFileCheckFragment.DirectorySearch)
+		config.FileContentsPredicate = ParsePredicate(check.RepositoryFileCheckFragment.FileContentsPredicate)
+		config.Filepaths = OptionalStringListValue(check.RepositoryFileCheckFragment.Filepaths)
+		config.UseAbsoluteRoot = RequiredBoolValue(check.RepositoryFileCheckFragment.UseAbsoluteRoot)
+	case opslevel.CheckTypeRepoGrep:
+		predicate := check.RepositoryGrepCheckFragment.FileContentsPredicate
+		config.DirectorySearch = RequiredBoolValue(check.RepositoryGrepCheckFragment.DirectorySearch)
+		config.FileContentsPredicate = types.ObjectValueMust(predicateType, map[string]attr.Value{
+			"type":  types.StringValue(string(predicate.Type)),
+			"value": OptionalStringValue(predicate.Value),
+		})
+		config.Filepaths = OptionalStringListValue(check.RepositoryGrepCheckFragment.Filepaths)
+	case opslevel.CheckTypeRepoSearch:
+		predicate := check.RepositorySearchCheckFragment.FileContentsPredicate
+		config.FileContentsPredicate = types.ObjectValueMust(predicateType, map[string]attr.Value{
+			"type":  types.StringValue(string(predicate.Type)),
+			"value": OptionalStringValue(predicate.Value),
+		})
+		config.FileExtensions = OptionalStringListValue(check.RepositorySearchCheckFragment.FileExtensions)
+	case opslevel.CheckTypeServiceProperty:
+		config.Predicate = ParsePredicate(check.ServicePropertyCheckFragment.Predicate)
+		config.Property = RequiredStringValue(string(check.ServicePropertyCheckFragment.Property))
+	case opslevel.CheckTypeTagDefined:
+		config.TagKey = RequiredStringValue(check.TagKey)
+		config.TagPredicate = ParsePredicate(check.TagPredicate)
+	case opslevel.CheckTypeToolUsage:
+		config.EnvironmentPredicate = ParsePredicate(check.EnvironmentPredicate)
+		config.ToolCategory = RequiredStringValue(string(check.ToolCategory))
+		config.ToolNamePredicate = ParsePredicate(check.ToolNamePredicate)
+		config.ToolUrlPredicate = ParsePredicate(check.ToolUrlPredicate)
+	}
+	return config
+}
+
+func newCheckDataSourceModel(ctx context.Context, check opslevel.Check) (checkDataSourceModel, diag.Diagnostics) {
+	checkModel := checkDataSourceModel{
+		Category:    ComputedStringValue(string(check.Category.Id)),
+		Description: ComputedStringValue(check.Description),
+		Enabled:     types.BoolValue(check.Enabled),
+		EnableOn:    types.StringNull(),
+		Filter:      OptionalStringValue(string(check.Filter.Id)),
+		Id:          ComputedStringValue(string(check.Id)),
+		Level:       ComputedStringValue(string(check.Level.Id)),
+		Name:        ComputedStringValue(check.Name),
+		Notes:       OptionalStringValue(check.Notes),
+		Owner:       OptionalStringValue(string(check.Owner.Team.Id)),
+		Type:        ComputedStringValue(string(check.Type)),
+	}
+	if !check.EnableOn.IsZero() {
+		checkModel.EnableOn = types.StringValue(check.EnableOn.Format(time.RFC3339))
+	}
+
+	var diags diag.Diagnostics
+	checkModel.Config, diags = types.ObjectValueFrom(ctx, checkConfigType, newCheckConfigModel(check))
+	return checkModel, diags
+}
+
+func (d *CheckDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_check"
+}
+
+func (d *CheckDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Check data source",
+
+		Attributes: CheckAttributes(map[string]schema.Attribute{
+			"identifier": schema.StringAttribute{
+				Description: "The id of the check to find.",
+				Required:    true,
+				Validators:  []validator.String{IdStringValidator()},
+			},
+		}),
+	}
+}
+
+func (d *CheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	configModel := read[checkDataSourceWithIdentifierModel](ctx, &resp.Diagnostics, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	check, err := d.client.GetCheck(opslevel.ID(configModel.Identifier.ValueString()))
+	if err != nil || check == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read check datasource, got error: %s", err))
+		return
+	}
+
+	checkModel, diags := newCheckDataSourceModel(ctx, *check)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	stateModel := checkDataSourceWithIdentifierModel{
+		checkDataSourceModel: checkModel,
+		Identifier:           configModel.Identifier,
+	}
+
+	tflog.Trace(ctx, "read an OpsLevel Check data source")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+}
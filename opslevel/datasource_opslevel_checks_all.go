@@ -0,0 +1,164 @@
+package opslevel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+// Ensure CheckDataSourcesAll implements DataSourceWithConfigure interface
+var _ datasource.DataSourceWithConfigure = &CheckDataSourcesAll{}
+
+func NewCheckDataSourcesAll() datasource.DataSource {
+	return &CheckDataSourcesAll{}
+}
+
+// CheckDataSourcesAll manages a list of all Check data sources.
+type CheckDataSourcesAll struct {
+	CommonDataSourceClient
+}
+
+// checkDataSourcesAllModel describes the data source data model.
+type checkDataSourcesAllModel struct {
+	Category types.String           `tfsdk:"category"`
+	Checks   []checkDataSourceModel `tfsdk:"checks"`
+	Enabled  types.Bool             `tfsdk:"enabled"`
+	Filter   types.String           `tfsdk:"filter"`
+	Level    types.String           `tfsdk:"level"`
+	Owner    types.String           `tfsdk:"owner"`
+	Type     types.String           `tfsdk:"type"`
+}
+
+// checkListFilter holds the optional arguments of the opslevel_checks data source, unset fields match every check
+type checkListFilter struct {
+	Category opslevel.ID
+	Enabled  *bool
+	Filter   opslevel.ID
+	Level    opslevel.ID
+	Owner    opslevel.ID
+	Type     opslevel.CheckType
+}
+
+func (f checkListFilter) matches(check opslevel.Check) bool {
+	switch {
+	case f.Category != "" && check.Category.Id != f.Category:
+		return false
+	case f.Enabled != nil && check.Enabled != *f.Enabled:
+		return false
+	case f.Filter != "" && check.Filter.Id != f.Filter:
+		return false
+	case f.Level != "" && check.Level.Id != f.Level:
+		return false
+	case f.Owner != "" && check.Owner.Team.Id != f.Owner:
+		return false
+	case f.Type != "" && check.Type != f.Type:
+		return false
+	}
+	return true
+}
+
+func (d *CheckDataSourcesAll) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_checks"
+}
+
+func (d *CheckDataSourcesAll) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List of all Check data sources",
+
+		Attributes: map[string]schema.Attribute{
+			"category": schema.StringAttribute{
+				Description: "Only list the checks of the category with this id.",
+				Optional:    true,
+				Validators:  []validator.String{IdStringValidator()},
+			},
+			"checks": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: checkSchemaAttrs,
+				},
+				Description: "List of Check data sources",
+				Computed:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Only list the checks that are enabled, or disabled when false.",
+				Optional:    true,
+			},
+			"filter": schema.StringAttribute{
+				Description: "Only list the checks using the filter with this id.",
+				Optional:    true,
+				Validators:  []validator.String{IdStringValidator()},
+			},
+			"level": schema.StringAttribute{
+				Description: "Only list the checks of the level with this id.",
+				Optional:    true,
+				Validators:  []validator.String{IdStringValidator()},
+			},
+			"owner": schema.StringAttribute{
+				Description: "Only list the checks owned by the team with this id or alias.",
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: fmt.Sprintf(
+					"Only list the checks of this type. One of `%s`",
+					strings.Join(opslevel.AllCheckType, "`, `"),
+				),
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.OneOf(opslevel.AllCheckType...)},
+			},
+		},
+	}
+}
+
+func (d *CheckDataSourcesAll) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	configModel := read[checkDataSourcesAllModel](ctx, &resp.Diagnostics, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listFilter := checkListFilter{
+		Category: opslevel.ID(configModel.Category.ValueString()),
+		Enabled:  configModel.Enabled.ValueBoolPointer(),
+		Filter:   opslevel.ID(configModel.Filter.ValueString()),
+		Level:    opslevel.ID(configModel.Level.ValueString()),
+		Type:     opslevel.CheckType(configModel.Type.ValueString()),
+	}
+	if owner := configModel.Owner.ValueString(); owner != "" {
+		teamId, err := d.cache.resolveTeamID(d.client, owner)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find owner '%s' of checks, got error: %s", owner, err))
+			return
+		}
+		listFilter.Owner = teamId
+	}
+
+	checks, err := d.client.ListChecks(nil)
+	if err != nil || checks == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list checks datasource, got error: %s", err))
+		return
+	}
+
+	stateModel := configModel
+	stateModel.Checks = []checkDataSourceModel{}
+	for _, check := range checks.Nodes {
+		if !listFilter.matches(check) {
+			continue
+		}
+		checkModel, diags := newCheckDataSourceModel(ctx, check)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		stateModel.Checks = append(stateModel.Checks, checkModel)
+	}
+
+	// Save data into Terraform state
+	tflog.Trace(ctx, "listed all OpsLevel Check data sources")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+}
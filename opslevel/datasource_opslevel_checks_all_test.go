@@ -0,0 +1,52 @@
+package opslevel
+
+import (
+	"testing"
+
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+func TestCheckListFilterMatches(t *testing.T) {
+	var check opslevel.Check
+	check.Category.Id = "category"
+	check.Enabled = true
+	check.Level.Id = "level"
+	check.Owner.Team.Id = "team"
+	check.Type = opslevel.CheckTypeManual
+
+	tests := map[string]struct {
+		filter   checkListFilter
+		expected bool
+	}{
+		"empty filter matches every check": {filter: checkListFilter{}, expected: true},
+		"matching fields":                  {filter: checkListFilter{Category: "category", Level: "level", Owner: "team", Type: opslevel.CheckTypeManual}, expected: true},
+		"other category":                   {filter: checkListFilter{Category: "other"}, expected: false},
+		"other level":                      {filter: checkListFilter{Level: "other"}, expected: false},
+		"other owner":                      {filter: checkListFilter{Owner: "other"}, expected: false},
+		"other type":                       {filter: checkListFilter{Type: opslevel.CheckTypeToolUsage}, expected: false},
+		"check without filter":             {filter: checkListFilter{Filter: "filter"}, expected: false},
+		"enabled":                          {filter: checkListFilter{Enabled: opslevel.RefOf(true)}, expected: true},
+		"disabled":                         {filter: checkListFilter{Enabled: opslevel.RefOf(false)}, expected: false},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := test.filter.matches(check); got != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestNewCheckConfigModel(t *testing.T) {
+	var check opslevel.Check
+	check.Type = opslevel.CheckTypeTagDefined
+	check.TagKey = "tier"
+
+	config := newCheckConfigModel(check)
+	if config.TagKey.ValueString() != "tier" || !config.TagPredicate.IsNull() {
+		t.Errorf("expected the tag defined settings, got %+v", config)
+	}
+	if !config.ToolCategory.IsNull() || !config.Days.IsNull() || !config.Filepaths.IsNull() {
+		t.Errorf("expected the settings of other check types to be null, got %+v", config)
+	}
+}
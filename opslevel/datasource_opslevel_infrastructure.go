@@ -0,0 +1,162 @@
+package opslevel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+// Ensure InfrastructureDataSource implements DataSourceWithConfigure interface
+var _ datasource.DataSourceWithConfigure = &InfrastructureDataSource{}
+
+func NewInfrastructureDataSource() datasource.DataSource {
+	return &InfrastructureDataSource{}
+}
+
+// InfrastructureDataSource manages an Infrastructure data source.
+type InfrastructureDataSource struct {
+	CommonDataSourceClient
+}
+
+var infrastructureSchemaAttrs = map[string]schema.Attribute{
+	"aliases": schema.ListAttribute{
+		ElementType: types.StringType,
+		Description: "The aliases of the infrastructure resource.",
+		Computed:    true,
+	},
+	"data": schema.StringAttribute{
+		Description: "The data of the infrastructure resource in JSON format.",
+		Computed:    true,
+	},
+	"id": schema.StringAttribute{
+		Description: "The ID of the infrastructure resource.",
+		Computed:    true,
+	},
+	"name": schema.StringAttribute{
+		Description: "The name of the infrastructure resource.",
+		Computed:    true,
+	},
+	"owner": schema.StringAttribute{
+		Description: "The id of the team that owns the infrastructure resource.",
+		Computed:    true,
+	},
+	"provider_data": schema.SingleNestedAttribute{
+		Description: "The provider specific data for the infrastructure resource.",
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"account": schema.StringAttribute{
+				Description: "The canonical account name for the provider of the infrastructure resource.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the provider of the infrastructure resource. (eg. AWS, GCP, Azure)",
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the infrastructure resource as defined by its provider.",
+				Computed:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "The url for the provider of the infrastructure resource.",
+				Computed:    true,
+			},
+		},
+	},
+	"schema": schema.StringAttribute{
+		Description: "The schema of the infrastructure resource that determines its data specification.",
+		Computed:    true,
+	},
+	"tags": schema.ListAttribute{
+		ElementType: types.StringType,
+		Description: "The tags applied to the infrastructure resource.",
+		Computed:    true,
+	},
+}
+
+func InfrastructureAttributes(attrs map[string]schema.Attribute) map[string]schema.Attribute {
+	for key, value := range infrastructureSchemaAttrs {
+		attrs[key] = value
+	}
+	return attrs
+}
+
+// infrastructureDataSourceModel describes the data source data model.
+type infrastructureDataSourceModel struct {
+	Aliases      types.List         `tfsdk:"aliases"`
+	Data         types.String       `tfsdk:"data"`
+	Id           types.String       `tfsdk:"id"`
+	Name         types.String       `tfsdk:"name"`
+	Owner        types.String       `tfsdk:"owner"`
+	ProviderData *InfraProviderData `tfsdk:"provider_data"`
+	Schema       types.String       `tfsdk:"schema"`
+	Tags         types.List         `tfsdk:"tags"`
+}
+
+type infrastructureDataSourceWithIdentifierModel struct {
+	infrastructureDataSourceModel
+	Identifier types.String `tfsdk:"identifier"`
+}
+
+func newInfrastructureDataSourceModel(infrastructure opslevel.InfrastructureResource, tags []opslevel.Tag) infrastructureDataSourceModel {
+	infrastructureModel := infrastructureDataSourceModel{
+		Aliases: OptionalStringListValue(infrastructure.Aliases),
+		Data:    OptionalStringValue(infrastructure.Data.ToJSON()),
+		Id:      ComputedStringValue(string(infrastructure.Id)),
+		Name:    ComputedStringValue(infrastructure.Name),
+		Owner:   OptionalStringValue(string(infrastructure.Owner.Id())),
+		Schema:  ComputedStringValue(infrastructure.Schema),
+	}
+	if infrastructure.ProviderData.AccountName != "" {
+		infrastructureModel.ProviderData = newInfraProviderData(infrastructure)
+	}
+	infrastructureModel.Tags = OptionalStringListValue(flattenTagArray(tags))
+	return infrastructureModel
+}
+
+func (d *InfrastructureDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_infrastructure"
+}
+
+func (d *InfrastructureDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Infrastructure data source",
+
+		Attributes: InfrastructureAttributes(map[string]schema.Attribute{
+			"identifier": schema.StringAttribute{
+				Description: "The id or alias of the infrastructure resource to find.",
+				Required:    true,
+			},
+		}),
+	}
+}
+
+func (d *InfrastructureDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	configModel := read[infrastructureDataSourceWithIdentifierModel](ctx, &resp.Diagnostics, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	infrastructure, err := d.client.GetInfrastructure(configModel.Identifier.ValueString())
+	if err != nil || infrastructure == nil || infrastructure.Id == "" {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read infrastructure datasource, got error: %s", err))
+		return
+	}
+
+	tags, diags := getTagsFromResource(d.client, infrastructure)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	stateModel := infrastructureDataSourceWithIdentifierModel{
+		infrastructureDataSourceModel: newInfrastructureDataSourceModel(*infrastructure, tags.Nodes),
+		Identifier:                    configModel.Identifier,
+	}
+
+	tflog.Trace(ctx, "read an OpsLevel Infrastructure data source")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+}
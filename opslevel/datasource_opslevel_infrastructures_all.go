@@ -0,0 +1,180 @@
+package opslevel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+// Ensure InfrastructureDataSourcesAll implements DataSourceWithConfigure interface
+var _ datasource.DataSourceWithConfigure = &InfrastructureDataSourcesAll{}
+
+func NewInfrastructureDataSourcesAll() datasource.DataSource {
+	return &InfrastructureDataSourcesAll{}
+}
+
+// InfrastructureDataSourcesAll manages a list of all Infrastructure data sources.
+type InfrastructureDataSourcesAll struct {
+	CommonDataSourceClient
+}
+
+// infrastructureDataSourcesAllModel describes the data source data model.
+type infrastructureDataSourcesAllModel struct {
+	Account         types.String                    `tfsdk:"account"`
+	Infrastructures []infrastructureDataSourceModel `tfsdk:"infrastructures"`
+	Owner           types.String                    `tfsdk:"owner"`
+	ProviderType    types.String                    `tfsdk:"provider_type"`
+}
+
+// infrastructureListFilter holds the optional arguments of the opslevel_infrastructures data source, unset fields match everything
+type infrastructureListFilter struct {
+	Account      string
+	Owner        opslevel.ID
+	ProviderType string
+}
+
+func (f infrastructureListFilter) matches(infrastructure opslevel.InfrastructureResource) bool {
+	switch {
+	case f.Account != "" && infrastructure.ProviderData.AccountName != f.Account:
+		return false
+	case f.Owner != "" && infrastructure.Owner.Id() != f.Owner:
+		return false
+	case f.ProviderType != "" && !strings.EqualFold(infrastructure.ProviderType, f.ProviderType):
+		return false
+	}
+	return true
+}
+
+// infrastructureResourceWithTags is an infrastructure resource read together with the first page of its tags
+type infrastructureResourceWithTags struct {
+	opslevel.InfrastructureResource
+	Tags struct {
+		Nodes    []opslevel.Tag
+		PageInfo struct {
+			HasNextPage bool
+			EndCursor   string
+		}
+	}
+}
+
+// infrastructureResourcesQuery lists infrastructure resources with their tags, opslevel-go reads the tags of each one with its own request
+type infrastructureResourcesQuery struct {
+	Account struct {
+		InfrastructureResources struct {
+			Nodes    []infrastructureResourceWithTags
+			PageInfo struct {
+				HasNextPage bool
+				EndCursor   string
+			}
+		} `graphql:"infrastructureResources(after: $after, first: $first)"`
+	}
+}
+
+// listInfrastructuresWithTags returns every infrastructure resource of the account, only reading tags on their own when they don't fit on a page
+func listInfrastructuresWithTags(client *opslevel.Client, d *diag.Diagnostics) []infrastructureResourceWithTags {
+	var infrastructures []infrastructureResourceWithTags
+	variables := *client.InitialPageVariablesPointer()
+	for {
+		var q infrastructureResourcesQuery
+		if err := client.Query(&q, variables); err != nil {
+			d.AddError("Client Error", fmt.Sprintf("Unable to list infrastructures datasource, got error: %s", err))
+			return nil
+		}
+		infrastructures = append(infrastructures, q.Account.InfrastructureResources.Nodes...)
+		if !q.Account.InfrastructureResources.PageInfo.HasNextPage {
+			break
+		}
+		variables["after"] = q.Account.InfrastructureResources.PageInfo.EndCursor
+	}
+
+	for i := range infrastructures {
+		infrastructure := &infrastructures[i]
+		if !infrastructure.Tags.PageInfo.HasNextPage {
+			continue
+		}
+		tags, diags := getTagsFromResource(client, &infrastructure.InfrastructureResource)
+		d.Append(diags...)
+		if diags.HasError() {
+			return nil
+		}
+		infrastructure.Tags.Nodes = tags.Nodes
+	}
+	return infrastructures
+}
+
+func (d *InfrastructureDataSourcesAll) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_infrastructures"
+}
+
+func (d *InfrastructureDataSourcesAll) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List of all Infrastructure data sources. The infrastructure resources of the OpsLevel API don't refer to the integration that discovered them, only to the provider account it reports, so filter by that `account` to list the resources of an integration.",
+
+		Attributes: map[string]schema.Attribute{
+			"account": schema.StringAttribute{
+				Description: "Only list the infrastructure resources of this provider account.",
+				Optional:    true,
+			},
+			"infrastructures": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: infrastructureSchemaAttrs,
+				},
+				Description: "List of Infrastructure data sources",
+				Computed:    true,
+			},
+			"owner": schema.StringAttribute{
+				Description: "Only list the infrastructure resources owned by the team with this id or alias.",
+				Optional:    true,
+			},
+			"provider_type": schema.StringAttribute{
+				Description: "Only list the infrastructure resources of this type as defined by their provider, compared case-insensitively.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (d *InfrastructureDataSourcesAll) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	configModel := read[infrastructureDataSourcesAllModel](ctx, &resp.Diagnostics, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listFilter := infrastructureListFilter{
+		Account:      configModel.Account.ValueString(),
+		ProviderType: configModel.ProviderType.ValueString(),
+	}
+	if owner := configModel.Owner.ValueString(); owner != "" {
+		teamId, err := d.cache.resolveTeamID(d.client, owner)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find owner '%s' of infrastructure resources, got error: %s", owner, err))
+			return
+		}
+		listFilter.Owner = teamId
+	}
+
+	infrastructures := listInfrastructuresWithTags(d.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateModel := configModel
+	stateModel.Infrastructures = []infrastructureDataSourceModel{}
+	for _, infrastructure := range infrastructures {
+		if !listFilter.matches(infrastructure.InfrastructureResource) {
+			continue
+		}
+		stateModel.Infrastructures = append(stateModel.Infrastructures, newInfrastructureDataSourceModel(infrastructure.InfrastructureResource, infrastructure.Tags.Nodes))
+	}
+
+	// Save data into Terraform state
+	tflog.Trace(ctx, "listed all OpsLevel Infrastructure data sources")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+}
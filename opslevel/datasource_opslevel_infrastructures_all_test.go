@@ -0,0 +1,67 @@
+package opslevel
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/opslevel/opslevel-go/v2026"
+	"github.com/opslevel/terraform-provider-opslevel/internal/fakeopslevel"
+)
+
+func TestInfrastructureListFilterMatches(t *testing.T) {
+	var database opslevel.InfrastructureResource
+	database.ProviderData.AccountName = "production"
+	database.ProviderType = "RDS Instance"
+
+	tests := map[string]struct {
+		filter   infrastructureListFilter
+		expected bool
+	}{
+		"no filter":                            {filter: infrastructureListFilter{}, expected: true},
+		"same account":                         {filter: infrastructureListFilter{Account: "production"}, expected: true},
+		"other account":                        {filter: infrastructureListFilter{Account: "staging"}, expected: false},
+		"provider type ignores case":           {filter: infrastructureListFilter{ProviderType: "rds instance"}, expected: true},
+		"other provider type":                  {filter: infrastructureListFilter{ProviderType: "S3 Bucket"}, expected: false},
+		"every filter must match":              {filter: infrastructureListFilter{Account: "production", ProviderType: "S3 Bucket"}, expected: false},
+		"owner filter skips unowned resources": {filter: infrastructureListFilter{Owner: "Z2lkOi8vb3BzbGV2ZWwvVGVhbS8x"}, expected: false},
+		"account and provider type both match": {filter: infrastructureListFilter{Account: "production", ProviderType: "RDS Instance"}, expected: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := test.filter.matches(database); got != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestListInfrastructuresWithTags(t *testing.T) {
+	api := fakeopslevel.NewServer()
+	defer api.Close()
+	api.Seed("infrastructureResource", map[string]any{
+		"name":         "orders-db",
+		"providerData": map[string]any{"accountName": "production"},
+		"tags":         map[string]any{"nodes": []any{map[string]any{"key": "env", "value": "production"}}},
+	})
+	api.Seed("infrastructureResource", map[string]any{"name": "orders-bucket"})
+	client := opslevel.NewGQLClient(opslevel.SetAPIToken("fake-token"), opslevel.SetURL(api.URL()), opslevel.SetMaxRetries(0))
+
+	var diags diag.Diagnostics
+	infrastructures := listInfrastructuresWithTags(client, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(infrastructures) != 2 {
+		t.Fatalf("expected 2 infrastructure resources, got %d", len(infrastructures))
+	}
+	if tags := flattenTagArray(infrastructures[0].Tags.Nodes); !slices.Equal(tags, []string{"env:production"}) {
+		t.Errorf("expected the tags of 'orders-db' to be read with it, got %v", tags)
+	}
+	if len(infrastructures[1].Tags.Nodes) != 0 {
+		t.Errorf("expected 'orders-bucket' to have no tags, got %v", infrastructures[1].Tags.Nodes)
+	}
+	if operations := api.Operations(); !slices.Equal(operations, []string{"account.infrastructureResources"}) {
+		t.Errorf("expected a single request for the resources and their tags, got %v", operations)
+	}
+}
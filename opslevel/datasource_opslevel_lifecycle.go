@@ -85,7 +85,7 @@ func (lifecycleDataSource *LifecycleDataSource) Read(ctx context.Context, req da
 		return
 	}
 
-	lifecycles, err := lifecycleDataSource.client.ListLifecycles()
+	lifecycles, err := lifecycleDataSource.cache.listLifecycles(lifecycleDataSource.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list lifecycles, got error: %s", err))
 		return
@@ -72,7 +72,7 @@ func (d *LifecycleDataSourcesAll) Schema(ctx context.Context, req datasource.Sch
 }
 
 func (d *LifecycleDataSourcesAll) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	lifecycles, err := d.client.ListLifecycles()
+	lifecycles, err := d.cache.listLifecycles(d.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to list lifecycles, got error: %s", err))
 		return
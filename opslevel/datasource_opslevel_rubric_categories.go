@@ -67,12 +67,12 @@ func (d *CategoryDataSourcesAll) Schema(ctx context.Context, req datasource.Sche
 }
 
 func (d *CategoryDataSourcesAll) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	categories, err := d.client.ListCategories(nil)
+	categories, err := d.cache.listCategories(d.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list rubric_categories datasource, got error: %s", err))
 		return
 	}
-	stateModel := NewCategoryDataSourcesAllModel(categories.Nodes)
+	stateModel := NewCategoryDataSourcesAllModel(categories)
 
 	// Save data into Terraform state
 	tflog.Trace(ctx, "listed all rubric_categories data sources")
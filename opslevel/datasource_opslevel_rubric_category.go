@@ -71,13 +71,13 @@ func (d *CategoryDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	categories, err := d.client.ListCategories(nil)
+	categories, err := d.cache.listCategories(d.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read rubric_category datasource, got error: %s", err))
 		return
 	}
 
-	category, err := filterRubricCategories(categories.Nodes, configModel.Filter)
+	category, err := filterRubricCategories(categories, configModel.Filter)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to filter rubric_category datasource, got error: %s", err))
 		return
@@ -84,13 +84,13 @@ func (d *LevelDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	levels, err := d.client.ListLevels(nil)
+	levels, err := d.cache.listLevels(d.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read rubric_level datasource, got error: %s", err))
 		return
 	}
 
-	level, err := filterRubricLevels(levels.Nodes, configModel.Filter)
+	level, err := filterRubricLevels(levels, configModel.Filter)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to filter rubric_level datasource, got error: %s", err))
 		return
@@ -148,7 +148,7 @@ func (d *ScorecardDataSource) Read(ctx context.Context, req datasource.ReadReque
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read scorecard datasource, got error: %s", err))
 		return
 	}
-	categoriesModel, diags := getCategoriesModelFromScorecard(d.client, scorecard)
+	categoriesModel, diags := getCategoriesModelFromScorecard(d.cache, d.client, scorecard)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
 		return
@@ -161,13 +161,13 @@ func (d *ScorecardDataSource) Read(ctx context.Context, req datasource.ReadReque
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
 }
 
-func getCategoriesModelFromScorecard(client *opslevel.Client, scorecard *opslevel.Scorecard) ([]categoryDataSourceModel, diag.Diagnostics) {
+func getCategoriesModelFromScorecard(cache *lookupCache, client *opslevel.Client, scorecard *opslevel.Scorecard) ([]categoryDataSourceModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	categories, err := scorecard.ListCategories(client, nil)
-	if err != nil || categories == nil {
+	categories, err := cache.listScorecardCategories(client, scorecard)
+	if err != nil {
 		diags.AddError("Client Error", fmt.Sprintf("Unable to list categories from scorecard with id '%s', got error: %s", scorecard.Id, err))
 	}
-	categoriesModel := NewCategoryDataSourcesAllModel(categories.Nodes)
+	categoriesModel := NewCategoryDataSourcesAllModel(categories)
 	return categoriesModel.RubricCategories, diags
 }
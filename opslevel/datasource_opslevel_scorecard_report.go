@@ -0,0 +1,322 @@
+package opslevel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+// Ensure ScorecardReportDataSource implements DataSourceWithConfigure interface
+var _ datasource.DataSourceWithConfigure = &ScorecardReportDataSource{}
+
+func NewScorecardReportDataSource() datasource.DataSource {
+	return &ScorecardReportDataSource{}
+}
+
+// ScorecardReportDataSource manages a Scorecard Report data source.
+type ScorecardReportDataSource struct {
+	CommonDataSourceClient
+}
+
+type scorecardReportCheckModel struct {
+	Category types.String `tfsdk:"category"`
+	Id       types.String `tfsdk:"id"`
+	Level    types.String `tfsdk:"level"`
+	Name     types.String `tfsdk:"name"`
+}
+
+type scorecardReportServiceModel struct {
+	Categories    []serviceMaturityCategoryModel `tfsdk:"categories"`
+	FailingChecks types.Int64                    `tfsdk:"failing_checks"`
+	Id            types.String                   `tfsdk:"id"`
+	Level         *levelDataSourceModel          `tfsdk:"level"`
+	Name          types.String                   `tfsdk:"name"`
+	PassingChecks types.Int64                    `tfsdk:"passing_checks"`
+}
+
+type scorecardReportModel struct {
+	Categories    []categoryDataSourceModel     `tfsdk:"categories"`
+	Checks        []scorecardReportCheckModel   `tfsdk:"checks"`
+	Id            types.String                  `tfsdk:"id"`
+	Name          types.String                  `tfsdk:"name"`
+	PassingChecks types.Int64                   `tfsdk:"passing_checks"`
+	ServiceCount  types.Int64                   `tfsdk:"service_count"`
+	Services      []scorecardReportServiceModel `tfsdk:"services"`
+	TotalChecks   types.Int64                   `tfsdk:"total_checks"`
+}
+
+// scorecardReportDataSourceModel describes the data source data model.
+type scorecardReportDataSourceModel struct {
+	Scorecard  types.String           `tfsdk:"scorecard"`
+	Scorecards []scorecardReportModel `tfsdk:"scorecards"`
+}
+
+// filterCheckResults returns the check results for which keep is true, still grouped by level
+func filterCheckResults(results []checkResultsByLevel, keep func(checkResult) bool) []checkResultsByLevel {
+	filtered := make([]checkResultsByLevel, 0, len(results))
+	for _, byLevel := range results {
+		kept := checkResultsByLevel{Level: byLevel.Level}
+		for _, result := range byLevel.Items.Nodes {
+			if keep(result) {
+				kept.Items.Nodes = append(kept.Items.Nodes, result)
+			}
+		}
+		filtered = append(filtered, kept)
+	}
+	return filtered
+}
+
+// countCheckResults returns the number of passed and failed check results, pending results are in neither
+func countCheckResults(results []checkResultsByLevel) (passing, failing int) {
+	for _, byLevel := range results {
+		for _, result := range byLevel.Items.Nodes {
+			switch result.Status {
+			case "passed":
+				passing++
+			case "failed":
+				failing++
+			}
+		}
+	}
+	return passing, failing
+}
+
+// newScorecardReportServiceModel maps the check results of a service on a scorecard, the level in each category follows from the checks in it
+func newScorecardReportServiceModel(service opslevel.Service, results []checkResultsByLevel, categoriesModel []categoryDataSourceModel) scorecardReportServiceModel {
+	passing, failing := countCheckResults(results)
+	serviceModel := scorecardReportServiceModel{
+		Categories:    []serviceMaturityCategoryModel{},
+		FailingChecks: types.Int64Value(int64(failing)),
+		Id:            ComputedStringValue(string(service.Id)),
+		Level:         newLevelDataSourceModel(achievedLevel(results)),
+		Name:          ComputedStringValue(service.Name),
+		PassingChecks: types.Int64Value(int64(passing)),
+	}
+	for _, category := range categoriesModel {
+		categoryId := opslevel.ID(category.Id.ValueString())
+		categoryResults := filterCheckResults(results, func(result checkResult) bool {
+			return result.Check.Category.Id == categoryId
+		})
+		serviceModel.Categories = append(serviceModel.Categories, serviceMaturityCategoryModel{
+			Category: category,
+			Level:    newLevelDataSourceModel(achievedLevel(categoryResults)),
+		})
+	}
+	return serviceModel
+}
+
+// listScorecardServices lists the services a scorecard applies to, the services matching its filter or every service when it has none
+func listScorecardServices(client *opslevel.Client, scorecard opslevel.Scorecard) ([]opslevel.Service, error) {
+	var services *opslevel.ServiceConnection
+	var err error
+	if scorecard.Filter.Id != "" {
+		services, err = client.ListServicesWithFilter(string(scorecard.Filter.Id), nil)
+	} else {
+		services, err = client.ListServices(nil)
+	}
+	if err != nil || services == nil {
+		return nil, err
+	}
+	return services.Nodes, nil
+}
+
+// newScorecardReportModel reports on the checks of a scorecard and on every service it applies to
+func (d *ScorecardReportDataSource) newScorecardReportModel(scorecard opslevel.Scorecard, checks []opslevel.Check, diags *diag.Diagnostics) scorecardReportModel {
+	categoriesModel, categoriesDiags := getCategoriesModelFromScorecard(d.cache, d.client, &scorecard)
+	diags.Append(categoriesDiags...)
+	if diags.HasError() {
+		return scorecardReportModel{}
+	}
+	isScorecardCategory := map[opslevel.ID]bool{}
+	for _, category := range categoriesModel {
+		isScorecardCategory[opslevel.ID(category.Id.ValueString())] = true
+	}
+
+	reportModel := scorecardReportModel{
+		Categories:    categoriesModel,
+		Checks:        []scorecardReportCheckModel{},
+		Id:            ComputedStringValue(string(scorecard.Id)),
+		Name:          ComputedStringValue(scorecard.Name),
+		PassingChecks: types.Int64Value(int64(scorecard.PassingChecks)),
+		ServiceCount:  types.Int64Value(int64(scorecard.ServiceCount)),
+		Services:      []scorecardReportServiceModel{},
+		TotalChecks:   types.Int64Value(int64(scorecard.TotalChecks)),
+	}
+	for _, check := range checks {
+		if !isScorecardCategory[check.Category.Id] {
+			continue
+		}
+		reportModel.Checks = append(reportModel.Checks, scorecardReportCheckModel{
+			Category: ComputedStringValue(string(check.Category.Id)),
+			Id:       ComputedStringValue(string(check.Id)),
+			Level:    ComputedStringValue(string(check.Level.Id)),
+			Name:     ComputedStringValue(check.Name),
+		})
+	}
+
+	services, err := listScorecardServices(d.client, scorecard)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list the services of scorecard '%s', got error: %s", scorecard.Name, err))
+		return reportModel
+	}
+	for _, service := range services {
+		maturity, err := getServiceMaturity(d.client, service.Id, &scorecard.Id)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read check results of service '%s' on scorecard '%s', got error: %s", service.Name, scorecard.Name, err))
+			return reportModel
+		}
+		reportModel.Services = append(reportModel.Services, newScorecardReportServiceModel(service, maturity.CheckResults.ByLevel.Nodes, categoriesModel))
+	}
+	return reportModel
+}
+
+func (d *ScorecardReportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scorecard_report"
+}
+
+func (d *ScorecardReportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Scorecard Report data source. Reads the check results of every service a reported scorecard applies to, one request per service, so reporting on every scorecard of a large account can take a while.",
+
+		Attributes: map[string]schema.Attribute{
+			"scorecard": schema.StringAttribute{
+				Description: "The id or alias of the scorecard to report on. When unset, every scorecard is reported on.",
+				Optional:    true,
+			},
+			"scorecards": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"categories": scorecardSchemaAttrs["categories"],
+						"checks": schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"category": schema.StringAttribute{
+										Description: "The id of the scorecard category the check belongs to.",
+										Computed:    true,
+									},
+									"id": schema.StringAttribute{
+										Description: "The id of the check.",
+										Computed:    true,
+									},
+									"level": schema.StringAttribute{
+										Description: "The id of the level the check belongs to.",
+										Computed:    true,
+									},
+									"name": schema.StringAttribute{
+										Description: "The display name of the check.",
+										Computed:    true,
+									},
+								},
+							},
+							Description: "The checks on the scorecard.",
+							Computed:    true,
+						},
+						"id":             scorecardSchemaAttrs["id"],
+						"name":           scorecardSchemaAttrs["name"],
+						"passing_checks": scorecardSchemaAttrs["passing_checks"],
+						"service_count":  scorecardSchemaAttrs["service_count"],
+						"services": schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"categories": schema.ListNestedAttribute{
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"category": schema.SingleNestedAttribute{
+													Description: "The scorecard category.",
+													Computed:    true,
+													Attributes:  rubricCategorySchemaAttrs,
+												},
+												"level": schema.SingleNestedAttribute{
+													Description: "The level the service has reached in the category, null when it has not reached any.",
+													Computed:    true,
+													Attributes:  rubricLevelSchemaAttrs,
+												},
+											},
+										},
+										Description: "The level of the service in each scorecard category.",
+										Computed:    true,
+									},
+									"failing_checks": schema.Int64Attribute{
+										Description: "The number of checks on the scorecard the service fails.",
+										Computed:    true,
+									},
+									"id": schema.StringAttribute{
+										Description: "The id of the service.",
+										Computed:    true,
+									},
+									"level": schema.SingleNestedAttribute{
+										Description: "The level the service has reached on the scorecard, null when it has not reached any.",
+										Computed:    true,
+										Attributes:  rubricLevelSchemaAttrs,
+									},
+									"name": schema.StringAttribute{
+										Description: "The name of the service.",
+										Computed:    true,
+									},
+									"passing_checks": schema.Int64Attribute{
+										Description: "The number of checks on the scorecard the service passes.",
+										Computed:    true,
+									},
+								},
+							},
+							Description: "Every service the scorecard applies to, including services whose check results are all pending.",
+							Computed:    true,
+						},
+						"total_checks": scorecardSchemaAttrs["total_checks"],
+					},
+				},
+				Description: "The report of each scorecard.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *ScorecardReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	configModel := read[scorecardReportDataSourceModel](ctx, &resp.Diagnostics, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var scorecards []opslevel.Scorecard
+	if identifier := configModel.Scorecard.ValueString(); identifier != "" {
+		scorecard, err := d.client.GetScorecard(identifier)
+		if err != nil || scorecard == nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read scorecard '%s', got error: %s", identifier, err))
+			return
+		}
+		scorecards = append(scorecards, *scorecard)
+	} else {
+		scorecardConnection, err := d.client.ListScorecards(nil)
+		if err != nil || scorecardConnection == nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list scorecards, got error: %s", err))
+			return
+		}
+		scorecards = scorecardConnection.Nodes
+	}
+
+	checks, err := d.client.ListChecks(nil)
+	if err != nil || checks == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list checks, got error: %s", err))
+		return
+	}
+
+	stateModel := configModel
+	stateModel.Scorecards = []scorecardReportModel{}
+	for _, scorecard := range scorecards {
+		reportModel := d.newScorecardReportModel(scorecard, checks.Nodes, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		stateModel.Scorecards = append(stateModel.Scorecards, reportModel)
+	}
+
+	tflog.Trace(ctx, "read an OpsLevel Scorecard Report data source")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+}
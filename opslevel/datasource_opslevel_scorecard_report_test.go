@@ -0,0 +1,107 @@
+package opslevel
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+func TestCountFilteredCheckResults(t *testing.T) {
+	result := func(checkId opslevel.ID, status string) checkResult {
+		var result checkResult
+		result.Check.Id = checkId
+		result.Status = status
+		return result
+	}
+	var bronze, silver checkResultsByLevel
+	bronze.Level.Index = 1
+	bronze.Items.Nodes = []checkResult{result("docs", "passed"), result("ownership", "failed")}
+	silver.Level.Index = 2
+	silver.Items.Nodes = []checkResult{result("docs-fresh", "pending"), result("ownership-contact", "passed")}
+	results := []checkResultsByLevel{bronze, silver}
+	checkCategories := map[opslevel.ID]opslevel.ID{"docs": "documentation", "docs-fresh": "documentation", "ownership": "ownership", "ownership-contact": "ownership"}
+
+	tests := map[string]struct {
+		category        opslevel.ID
+		expectedPassing int
+		expectedFailing int
+	}{
+		"documentation category":  {category: "documentation", expectedPassing: 1, expectedFailing: 0},
+		"ownership category":      {category: "ownership", expectedPassing: 1, expectedFailing: 1},
+		"category without checks": {category: "security", expectedPassing: 0, expectedFailing: 0},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			filtered := filterCheckResults(results, func(result checkResult) bool {
+				return checkCategories[result.Check.Id] == test.category
+			})
+			if len(filtered) != len(results) {
+				t.Errorf("expected every level to be kept, got %d levels", len(filtered))
+			}
+			passing, failing := countCheckResults(filtered)
+			if passing != test.expectedPassing || failing != test.expectedFailing {
+				t.Errorf("expected %d passing and %d failing, got %d and %d", test.expectedPassing, test.expectedFailing, passing, failing)
+			}
+		})
+	}
+}
+
+func TestNewScorecardReportServiceModel(t *testing.T) {
+	result := func(checkId opslevel.ID, categoryId opslevel.ID, status string) checkResult {
+		var result checkResult
+		result.Check.Id = checkId
+		result.Check.Category.Id = categoryId
+		result.Status = status
+		return result
+	}
+	byLevel := func(level string, index int, results ...checkResult) checkResultsByLevel {
+		byLevel := checkResultsByLevel{Level: opslevel.Level{Alias: level, Id: opslevel.ID(level), Index: index}}
+		byLevel.Items.Nodes = results
+		return byLevel
+	}
+	var service opslevel.Service
+	service.Id = "checkout"
+	service.Name = "Checkout"
+	categoriesModel := []categoryDataSourceModel{
+		{Id: types.StringValue("documentation"), Name: types.StringValue("Documentation")},
+		{Id: types.StringValue("ownership"), Name: types.StringValue("Ownership")},
+	}
+
+	t.Run("levels overall and per category", func(t *testing.T) {
+		results := []checkResultsByLevel{
+			byLevel("bronze", 1, result("docs", "documentation", "passed"), result("ownership", "ownership", "failed")),
+			byLevel("silver", 2, result("docs-fresh", "documentation", "passed")),
+		}
+		serviceModel := newScorecardReportServiceModel(service, results, categoriesModel)
+		if serviceModel.Id.ValueString() != "checkout" || serviceModel.Name.ValueString() != "Checkout" {
+			t.Errorf("expected service 'checkout' named 'Checkout', got '%s' named '%s'", serviceModel.Id.ValueString(), serviceModel.Name.ValueString())
+		}
+		if serviceModel.PassingChecks.ValueInt64() != 2 || serviceModel.FailingChecks.ValueInt64() != 1 {
+			t.Errorf("expected 2 passing and 1 failing, got %d and %d", serviceModel.PassingChecks.ValueInt64(), serviceModel.FailingChecks.ValueInt64())
+		}
+		if serviceModel.Level != nil {
+			t.Errorf("expected no level with a failing bronze check, got '%s'", serviceModel.Level.Id.ValueString())
+		}
+		if len(serviceModel.Categories) != 2 {
+			t.Fatalf("expected a level for each of the 2 categories, got %d", len(serviceModel.Categories))
+		}
+		if documentation := serviceModel.Categories[0]; documentation.Category.Id.ValueString() != "documentation" || documentation.Level == nil || documentation.Level.Id.ValueString() != "silver" {
+			t.Errorf("expected silver in documentation, got %v", documentation)
+		}
+		if ownership := serviceModel.Categories[1]; ownership.Category.Id.ValueString() != "ownership" || ownership.Level != nil {
+			t.Errorf("expected no level in ownership, got %v", ownership)
+		}
+	})
+
+	t.Run("service with only pending check results", func(t *testing.T) {
+		results := []checkResultsByLevel{byLevel("bronze", 1, result("docs", "documentation", "pending")), byLevel("silver", 2)}
+		serviceModel := newScorecardReportServiceModel(service, results, categoriesModel)
+		if serviceModel.Id.ValueString() != "checkout" {
+			t.Errorf("expected the service to be reported, got '%s'", serviceModel.Id.ValueString())
+		}
+		if serviceModel.PassingChecks.ValueInt64() != 0 || serviceModel.FailingChecks.ValueInt64() != 0 || serviceModel.Level != nil {
+			t.Errorf("expected no passing or failing checks and no level, got %v", serviceModel)
+		}
+	})
+}
@@ -43,14 +43,14 @@ type scorecardDataSourcesAllModel struct {
 	Scorecards []scorecardDataSourceModel `tfsdk:"scorecards"`
 }
 
-func NewScorecardDataSourcesAllModel(ctx context.Context, client *opslevel.Client, scorecards []opslevel.Scorecard) (scorecardDataSourcesAllModel, diag.Diagnostics) {
+func NewScorecardDataSourcesAllModel(ctx context.Context, cache *lookupCache, client *opslevel.Client, scorecards []opslevel.Scorecard) (scorecardDataSourcesAllModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	scorecardModels := []scorecardDataSourceModel{}
 	for _, scorecard := range scorecards {
 		scorecardAliases := OptionalStringListValue(scorecard.Aliases)
 
-		categoriesModel, categoriesDiags := getCategoriesModelFromScorecard(client, &scorecard)
+		categoriesModel, categoriesDiags := getCategoriesModelFromScorecard(cache, client, &scorecard)
 		diags.Append(categoriesDiags...)
 		if diags.HasError() {
 			return scorecardDataSourcesAllModel{}, diags
@@ -100,7 +100,7 @@ func (d *ScorecardDataSourcesAll) Read(ctx context.Context, req datasource.ReadR
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list scorecards datasource, got error: %s", err))
 		return
 	}
-	stateModel, diags := NewScorecardDataSourcesAllModel(ctx, d.client, scorecards.Nodes)
+	stateModel, diags := NewScorecardDataSourcesAllModel(ctx, d.cache, d.client, scorecards.Nodes)
 	resp.Diagnostics.Append(diags...)
 
 	// Save data into Terraform state
@@ -0,0 +1,381 @@
+package opslevel
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+// Ensure ServiceMaturityDataSource implements DataSourceWithConfigure interface
+var _ datasource.DataSourceWithConfigure = &ServiceMaturityDataSource{}
+
+func NewServiceMaturityDataSource() datasource.DataSource {
+	return &ServiceMaturityDataSource{}
+}
+
+// ServiceMaturityDataSource manages a Service Maturity data source.
+type ServiceMaturityDataSource struct {
+	CommonDataSourceClient
+}
+
+type checkResult struct {
+	Check struct {
+		Category struct {
+			Id   opslevel.ID
+			Name string
+		}
+		Id   opslevel.ID
+		Name string
+	}
+	LastUpdated string
+	Message     string
+	Status      string
+}
+
+// checkResultConnection is one page of the check results of a level, levels can have more checks than fit on a page
+type checkResultConnection struct {
+	Nodes    []checkResult
+	PageInfo struct {
+		HasNextPage bool
+		EndCursor   string
+	}
+}
+
+type checkResultsByLevel struct {
+	Level opslevel.Level
+	Items checkResultConnection `graphql:"items(after: $after)"`
+}
+
+type serviceMaturity struct {
+	MaturityReport struct {
+		CategoryBreakdown []struct {
+			Category opslevel.Category
+			Level    opslevel.Level
+		}
+		OverallLevel opslevel.Level
+	}
+	CheckResults struct {
+		ByLevel struct {
+			Nodes []checkResultsByLevel
+		}
+	} `graphql:"checkResults(scorecardId: $scorecard)"`
+}
+
+// serviceMaturityQuery reads the maturity report and the check results of a service, opslevel-go has no helper for them yet
+type serviceMaturityQuery struct {
+	Account struct {
+		Service serviceMaturity `graphql:"service(id: $service)"`
+	}
+}
+
+// serviceCheckResultsQuery reads the check results after a cursor of every level of a service
+type serviceCheckResultsQuery struct {
+	Account struct {
+		Service struct {
+			CheckResults struct {
+				ByLevel struct {
+					Nodes []checkResultsByLevel
+				}
+			} `graphql:"checkResults(scorecardId: $scorecard)"`
+		} `graphql:"service(id: $service)"`
+	}
+}
+
+// getServiceMaturity returns the check results of the rubric, or of the scorecard when scorecardId is set
+func getServiceMaturity(client *opslevel.Client, serviceId opslevel.ID, scorecardId *opslevel.ID) (serviceMaturity, error) {
+	var q serviceMaturityQuery
+	variables := opslevel.PayloadVariables{"service": serviceId, "scorecard": scorecardId, "after": ""}
+	if err := client.Query(&q, variables); err != nil {
+		return q.Account.Service, err
+	}
+
+	// The cursors of the API are offsets into a connection rather than tied to one, so a query with the cursor of one
+	// level reads the same page of every level. Levels that stopped at the same cursor are paged together by one query,
+	// levels at another cursor wait for a query with their own.
+	maturity := q.Account.Service
+	for {
+		after, hasNextPage := nextCheckResultsCursor(maturity.CheckResults.ByLevel.Nodes)
+		if !hasNextPage {
+			return maturity, nil
+		}
+		var page serviceCheckResultsQuery
+		variables["after"] = after
+		if err := client.Query(&page, variables); err != nil {
+			return maturity, err
+		}
+		for i := range maturity.CheckResults.ByLevel.Nodes {
+			byLevel := &maturity.CheckResults.ByLevel.Nodes[i]
+			if !byLevel.Items.PageInfo.HasNextPage || byLevel.Items.PageInfo.EndCursor != after {
+				continue
+			}
+			next := findCheckResultsOfLevel(page.Account.Service.CheckResults.ByLevel.Nodes, byLevel.Level.Id)
+			if next == nil {
+				return maturity, fmt.Errorf("the next page of check results has no level '%s'", byLevel.Level.Id)
+			}
+			byLevel.Items.Nodes = append(byLevel.Items.Nodes, next.Items.Nodes...)
+			byLevel.Items.PageInfo = next.Items.PageInfo
+		}
+	}
+}
+
+// nextCheckResultsCursor returns the cursor of the first level with more check results to read
+func nextCheckResultsCursor(results []checkResultsByLevel) (string, bool) {
+	for _, byLevel := range results {
+		if byLevel.Items.PageInfo.HasNextPage {
+			return byLevel.Items.PageInfo.EndCursor, true
+		}
+	}
+	return "", false
+}
+
+func findCheckResultsOfLevel(results []checkResultsByLevel, levelId opslevel.ID) *checkResultsByLevel {
+	for i := range results {
+		if results[i].Level.Id == levelId {
+			return &results[i]
+		}
+	}
+	return nil
+}
+
+type serviceMaturityCategoryModel struct {
+	Category categoryDataSourceModel `tfsdk:"category"`
+	Level    *levelDataSourceModel   `tfsdk:"level"`
+}
+
+type checkResultModel struct {
+	CheckId       types.String          `tfsdk:"check_id"`
+	CheckName     types.String          `tfsdk:"check_name"`
+	LastEvaluated types.String          `tfsdk:"last_evaluated"`
+	Level         *levelDataSourceModel `tfsdk:"level"`
+	Message       types.String          `tfsdk:"message"`
+	Status        types.String          `tfsdk:"status"`
+}
+
+// serviceMaturityDataSourceModel describes the data source data model.
+type serviceMaturityDataSourceModel struct {
+	Categories   []serviceMaturityCategoryModel `tfsdk:"categories"`
+	CheckResults []checkResultModel             `tfsdk:"check_results"`
+	Id           types.String                   `tfsdk:"id"`
+	Level        *levelDataSourceModel          `tfsdk:"level"`
+	Scorecard    types.String                   `tfsdk:"scorecard"`
+	Service      types.String                   `tfsdk:"service"`
+}
+
+func newLevelDataSourceModel(level opslevel.Level) *levelDataSourceModel {
+	if level.Id == "" {
+		return nil
+	}
+	return &levelDataSourceModel{
+		Alias: ComputedStringValue(level.Alias),
+		Id:    ComputedStringValue(string(level.Id)),
+		Index: types.Int64Value(int64(level.Index)),
+		Name:  ComputedStringValue(level.Name),
+	}
+}
+
+// achievedLevel returns the highest level for which every check of that level and of the levels below it passes
+func achievedLevel(results []checkResultsByLevel) opslevel.Level {
+	byIndex := slices.Clone(results)
+	slices.SortFunc(byIndex, func(a, b checkResultsByLevel) int {
+		return cmp.Compare(a.Level.Index, b.Level.Index)
+	})
+
+	var achieved opslevel.Level
+	for _, byLevel := range byIndex {
+		for _, result := range byLevel.Items.Nodes {
+			if result.Status != "passed" {
+				return achieved
+			}
+		}
+		achieved = byLevel.Level
+	}
+	return achieved
+}
+
+// newCheckResultCategoriesModel returns the level reached in each category of the check results, in the order the categories first appear
+func newCheckResultCategoriesModel(results []checkResultsByLevel) []serviceMaturityCategoryModel {
+	categoriesModel := []serviceMaturityCategoryModel{}
+	seen := map[opslevel.ID]bool{}
+	for _, byLevel := range results {
+		for _, result := range byLevel.Items.Nodes {
+			category := result.Check.Category
+			if seen[category.Id] {
+				continue
+			}
+			seen[category.Id] = true
+			categoryResults := filterCheckResults(results, func(result checkResult) bool {
+				return result.Check.Category.Id == category.Id
+			})
+			categoriesModel = append(categoriesModel, serviceMaturityCategoryModel{
+				Category: categoryDataSourceModel{
+					Id:   ComputedStringValue(string(category.Id)),
+					Name: ComputedStringValue(category.Name),
+				},
+				Level: newLevelDataSourceModel(achievedLevel(categoryResults)),
+			})
+		}
+	}
+	return categoriesModel
+}
+
+// newServiceMaturityDataSourceModel maps the maturity of a service onto the data source, onScorecard is set when the check results are of a scorecard
+func newServiceMaturityDataSourceModel(configModel serviceMaturityDataSourceModel, serviceId opslevel.ID, maturity serviceMaturity, onScorecard bool) serviceMaturityDataSourceModel {
+	stateModel := configModel
+	stateModel.Id = ComputedStringValue(string(serviceId))
+	if !onScorecard {
+		stateModel.Categories = []serviceMaturityCategoryModel{}
+		for _, breakdown := range maturity.MaturityReport.CategoryBreakdown {
+			stateModel.Categories = append(stateModel.Categories, serviceMaturityCategoryModel{
+				Category: categoryDataSourceModel{
+					Id:   ComputedStringValue(string(breakdown.Category.Id)),
+					Name: ComputedStringValue(breakdown.Category.Name),
+				},
+				Level: newLevelDataSourceModel(breakdown.Level),
+			})
+		}
+	} else {
+		stateModel.Categories = newCheckResultCategoriesModel(maturity.CheckResults.ByLevel.Nodes)
+	}
+	stateModel.CheckResults = []checkResultModel{}
+	for _, byLevel := range maturity.CheckResults.ByLevel.Nodes {
+		for _, result := range byLevel.Items.Nodes {
+			stateModel.CheckResults = append(stateModel.CheckResults, checkResultModel{
+				CheckId:       ComputedStringValue(string(result.Check.Id)),
+				CheckName:     ComputedStringValue(result.Check.Name),
+				LastEvaluated: OptionalStringValue(result.LastUpdated),
+				Level:         newLevelDataSourceModel(byLevel.Level),
+				Message:       ComputedStringValue(result.Message),
+				Status:        ComputedStringValue(result.Status),
+			})
+		}
+	}
+	// The maturity report only covers the rubric, the scorecard level follows from its check results
+	if !onScorecard {
+		stateModel.Level = newLevelDataSourceModel(maturity.MaturityReport.OverallLevel)
+	} else {
+		stateModel.Level = newLevelDataSourceModel(achievedLevel(maturity.CheckResults.ByLevel.Nodes))
+	}
+	return stateModel
+}
+
+func (d *ServiceMaturityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_maturity"
+}
+
+func (d *ServiceMaturityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Service Maturity data source",
+
+		Attributes: map[string]schema.Attribute{
+			"categories": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"category": schema.SingleNestedAttribute{
+							Description: "The rubric or scorecard category.",
+							Computed:    true,
+							Attributes:  rubricCategorySchemaAttrs,
+						},
+						"level": schema.SingleNestedAttribute{
+							Description: "The level the service has reached in the category, null when it has not reached any.",
+							Computed:    true,
+							Attributes:  rubricLevelSchemaAttrs,
+						},
+					},
+				},
+				Description: "The level of the service in each rubric category, or in each category of the scorecard with checks when `scorecard` is set.",
+				Computed:    true,
+			},
+			"check_results": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"check_id": schema.StringAttribute{
+							Description: "The id of the check.",
+							Computed:    true,
+						},
+						"check_name": schema.StringAttribute{
+							Description: "The display name of the check.",
+							Computed:    true,
+						},
+						"last_evaluated": schema.StringAttribute{
+							Description: "The time the check was last evaluated against the service.",
+							Computed:    true,
+						},
+						"level": schema.SingleNestedAttribute{
+							Description: "The level the check belongs to.",
+							Computed:    true,
+							Attributes:  rubricLevelSchemaAttrs,
+						},
+						"message": schema.StringAttribute{
+							Description: "The message of the check result.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the check result, such as `passed` or `failed`.",
+							Computed:    true,
+						},
+					},
+				},
+				Description: "The result of every check of the rubric, or of the scorecard when `scorecard` is set.",
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The id of the service.",
+				Computed:    true,
+			},
+			"level": schema.SingleNestedAttribute{
+				Description: "The level the service has reached on the rubric, or on the scorecard when `scorecard` is set. Null when it has not reached any level.",
+				Computed:    true,
+				Attributes:  rubricLevelSchemaAttrs,
+			},
+			"scorecard": schema.StringAttribute{
+				Description: "The id or alias of a scorecard to return the level and check results of instead of the rubric.",
+				Optional:    true,
+			},
+			"service": schema.StringAttribute{
+				Description: "The id or alias of the service.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (d *ServiceMaturityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	configModel := read[serviceMaturityDataSourceModel](ctx, &resp.Diagnostics, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err := getService(d.client, configModel.Service.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service '%s', got error: %s", configModel.Service.ValueString(), err))
+		return
+	}
+
+	var scorecardId *opslevel.ID
+	if scorecardIdentifier := configModel.Scorecard.ValueString(); scorecardIdentifier != "" {
+		scorecard, err := d.client.GetScorecard(scorecardIdentifier)
+		if err != nil || scorecard == nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read scorecard '%s', got error: %s", scorecardIdentifier, err))
+			return
+		}
+		scorecardId = &scorecard.Id
+	}
+
+	maturity, err := getServiceMaturity(d.client, service.Id, scorecardId)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read maturity of service '%s', got error: %s", configModel.Service.ValueString(), err))
+		return
+	}
+
+	stateModel := newServiceMaturityDataSourceModel(configModel, service.Id, maturity, scorecardId != nil)
+
+	tflog.Trace(ctx, "read an OpsLevel Service Maturity data source")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+}
@@ -0,0 +1,191 @@
+package opslevel
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+func TestAchievedLevel(t *testing.T) {
+	byLevel := func(alias string, index int, statuses ...string) checkResultsByLevel {
+		var results checkResultsByLevel
+		results.Level = opslevel.Level{Alias: alias, Id: opslevel.ID(alias), Index: index}
+		for _, status := range statuses {
+			results.Items.Nodes = append(results.Items.Nodes, checkResult{Status: status})
+		}
+		return results
+	}
+	beginner, bronze, silver := byLevel("beginner", 0), byLevel("bronze", 1, "passed", "passed"), byLevel("silver", 2, "passed")
+	failingBronze, pendingSilver := byLevel("bronze", 1, "passed", "failed"), byLevel("silver", 2, "pending")
+
+	tests := map[string]struct {
+		results  []checkResultsByLevel
+		expected string
+	}{
+		"no levels":                                {results: nil, expected: ""},
+		"every check passes":                       {results: []checkResultsByLevel{beginner, bronze, silver}, expected: "silver"},
+		"levels are sorted by index":               {results: []checkResultsByLevel{silver, beginner, bronze}, expected: "silver"},
+		"a failing check stops at the level below": {results: []checkResultsByLevel{beginner, failingBronze, silver}, expected: "beginner"},
+		"a pending check is not passing":           {results: []checkResultsByLevel{beginner, bronze, pendingSilver}, expected: "bronze"},
+		"failing the lowest level":                 {results: []checkResultsByLevel{failingBronze, silver}, expected: ""},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := achievedLevel(test.results); got.Alias != test.expected {
+				t.Errorf("expected level '%s', got '%s'", test.expected, got.Alias)
+			}
+		})
+	}
+}
+
+func TestGetServiceMaturityPagesCheckResults(t *testing.T) {
+	var variables []map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var gqlReq struct {
+			Query     string         `json:"query"`
+			Variables map[string]any `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&gqlReq); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if !strings.Contains(gqlReq.Query, "items(after: $after)") {
+			t.Errorf("expected the check results of each level to be paged, got query: %s", gqlReq.Query)
+		}
+		variables = append(variables, gqlReq.Variables)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch gqlReq.Variables["after"] {
+		case "":
+			w.Write([]byte(`{"data":{"account":{"service":{` +
+				`"maturityReport":{"categoryBreakdown":[{"category":{"id":"security","name":"Security"},"level":{"id":"bronze","index":1,"name":"Bronze"}}],"overallLevel":{"id":"bronze","index":1,"name":"Bronze"}},` +
+				`"checkResults":{"byLevel":{"nodes":[` +
+				`{"level":{"id":"bronze","index":1,"name":"Bronze"},"items":{"nodes":[{"check":{"id":"docs","name":"Docs"},"lastUpdated":"2026-01-02T03:04:05Z","message":"ok","status":"passed"}],"pageInfo":{"hasNextPage":true,"endCursor":"1"}}},` +
+				`{"level":{"id":"silver","index":2,"name":"Silver"},"items":{"nodes":[{"check":{"id":"tests","name":"Tests"},"lastUpdated":"","message":"no tests","status":"failed"}],"pageInfo":{"hasNextPage":true,"endCursor":"1"}}},` +
+				`{"level":{"id":"gold","index":3,"name":"Gold"},"items":{"nodes":[],"pageInfo":{"hasNextPage":false,"endCursor":""}}}` +
+				`]}}}}}}`))
+		case "1":
+			if strings.Contains(gqlReq.Query, "maturityReport") {
+				t.Errorf("expected the next page to only read check results, got query: %s", gqlReq.Query)
+			}
+			w.Write([]byte(`{"data":{"account":{"service":{"checkResults":{"byLevel":{"nodes":[` +
+				`{"level":{"id":"bronze","index":1,"name":"Bronze"},"items":{"nodes":[{"check":{"id":"owner","name":"Owner"},"lastUpdated":"","message":"no owner","status":"failed"}],"pageInfo":{"hasNextPage":false,"endCursor":"2"}}},` +
+				`{"level":{"id":"silver","index":2,"name":"Silver"},"items":{"nodes":[{"check":{"id":"alerts","name":"Alerts"},"lastUpdated":"","message":"ok","status":"passed"}],"pageInfo":{"hasNextPage":true,"endCursor":"2"}}},` +
+				`{"level":{"id":"gold","index":3,"name":"Gold"},"items":{"nodes":[],"pageInfo":{"hasNextPage":false,"endCursor":""}}}` +
+				`]}}}}}}`))
+		case "2":
+			w.Write([]byte(`{"data":{"account":{"service":{"checkResults":{"byLevel":{"nodes":[` +
+				`{"level":{"id":"bronze","index":1,"name":"Bronze"},"items":{"nodes":[],"pageInfo":{"hasNextPage":false,"endCursor":""}}},` +
+				`{"level":{"id":"silver","index":2,"name":"Silver"},"items":{"nodes":[{"check":{"id":"runbook","name":"Runbook"},"lastUpdated":"","message":"ok","status":"passed"}],"pageInfo":{"hasNextPage":false,"endCursor":"3"}}},` +
+				`{"level":{"id":"gold","index":3,"name":"Gold"},"items":{"nodes":[],"pageInfo":{"hasNextPage":false,"endCursor":""}}}` +
+				`]}}}}}}`))
+		default:
+			t.Errorf("unexpected cursor %v", gqlReq.Variables["after"])
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	maturity, err := getServiceMaturity(newTestClient(server.URL), "service", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Both levels stopped at cursor "1" are paged by the same query, so only silver needs a third one
+	if len(variables) != 3 {
+		t.Fatalf("expected 3 requests, got %d", len(variables))
+	}
+	if variables[1]["service"] != "service" {
+		t.Errorf("expected the next page to be of the same service, got %v", variables[1]["service"])
+	}
+
+	var checkIds []opslevel.ID
+	for _, byLevel := range maturity.CheckResults.ByLevel.Nodes {
+		for _, result := range byLevel.Items.Nodes {
+			checkIds = append(checkIds, result.Check.Id)
+		}
+	}
+	if !slices.Equal(checkIds, []opslevel.ID{"docs", "owner", "tests", "alerts", "runbook"}) {
+		t.Errorf("expected the check results of every page in level order, got %v", checkIds)
+	}
+	if maturity.MaturityReport.OverallLevel.Id != "bronze" {
+		t.Errorf("expected the maturity report of the first page, got level '%s'", maturity.MaturityReport.OverallLevel.Id)
+	}
+}
+
+func TestNewServiceMaturityDataSourceModel(t *testing.T) {
+	bronze := opslevel.Level{Alias: "bronze", Id: "bronze", Index: 1, Name: "Bronze"}
+	silver := opslevel.Level{Alias: "silver", Id: "silver", Index: 2, Name: "Silver"}
+	var maturity serviceMaturity
+	maturity.MaturityReport.OverallLevel = silver
+	maturity.MaturityReport.CategoryBreakdown = append(maturity.MaturityReport.CategoryBreakdown, struct {
+		Category opslevel.Category
+		Level    opslevel.Level
+	}{Category: opslevel.Category{Id: "security", Name: "Security"}})
+	passed := checkResult{LastUpdated: "2026-01-02T03:04:05Z", Message: "ok", Status: "passed"}
+	passed.Check.Id, passed.Check.Name = "docs", "Docs"
+	passed.Check.Category.Id, passed.Check.Category.Name = "documentation", "Documentation"
+	failed := checkResult{Message: "no tests", Status: "failed"}
+	failed.Check.Id, failed.Check.Name = "tests", "Tests"
+	failed.Check.Category.Id, failed.Check.Category.Name = "quality", "Quality"
+	bronzeResults := checkResultsByLevel{Level: bronze}
+	bronzeResults.Items.Nodes = []checkResult{passed}
+	silverResults := checkResultsByLevel{Level: silver}
+	silverResults.Items.Nodes = []checkResult{failed}
+	maturity.CheckResults.ByLevel.Nodes = []checkResultsByLevel{bronzeResults, silverResults}
+	configModel := serviceMaturityDataSourceModel{Service: types.StringValue("checkout")}
+
+	tests := map[string]struct {
+		onScorecard        bool
+		expectedLevel      string
+		expectedCategories []string
+	}{
+		"rubric uses the maturity report": {
+			onScorecard:        false,
+			expectedLevel:      "silver",
+			expectedCategories: []string{"Security:"},
+		},
+		"scorecard uses the check results": {
+			onScorecard:        true,
+			expectedLevel:      "bronze",
+			expectedCategories: []string{"Documentation:silver", "Quality:bronze"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			stateModel := newServiceMaturityDataSourceModel(configModel, "service", maturity, test.onScorecard)
+			if stateModel.Id.ValueString() != "service" || stateModel.Service.ValueString() != "checkout" {
+				t.Errorf("expected id 'service' of service 'checkout', got '%s' of '%s'", stateModel.Id.ValueString(), stateModel.Service.ValueString())
+			}
+			if stateModel.Level == nil || stateModel.Level.Id.ValueString() != test.expectedLevel {
+				t.Errorf("expected level '%s', got %v", test.expectedLevel, stateModel.Level)
+			}
+			var categories []string
+			for _, category := range stateModel.Categories {
+				level := ""
+				if category.Level != nil {
+					level = category.Level.Id.ValueString()
+				}
+				categories = append(categories, category.Category.Name.ValueString()+":"+level)
+			}
+			if !slices.Equal(categories, test.expectedCategories) {
+				t.Errorf("expected categories %v, got %v", test.expectedCategories, categories)
+			}
+			if len(stateModel.CheckResults) != 2 {
+				t.Fatalf("expected 2 check results, got %d", len(stateModel.CheckResults))
+			}
+			first, second := stateModel.CheckResults[0], stateModel.CheckResults[1]
+			if first.CheckId.ValueString() != "docs" || first.CheckName.ValueString() != "Docs" || first.Status.ValueString() != "passed" ||
+				first.Message.ValueString() != "ok" || first.LastEvaluated.ValueString() != "2026-01-02T03:04:05Z" || first.Level.Id.ValueString() != "bronze" {
+				t.Errorf("unexpected first check result %v", first)
+			}
+			if second.CheckId.ValueString() != "tests" || second.Status.ValueString() != "failed" || !second.LastEvaluated.IsNull() || second.Level.Id.ValueString() != "silver" {
+				t.Errorf("unexpected second check result %v", second)
+			}
+		})
+	}
+}
@@ -3,10 +3,18 @@ package opslevel
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/opslevel/opslevel-go/v2026"
@@ -26,19 +34,24 @@ type ServiceDataSourcesAll struct {
 
 // serviceDataSourcesAllModel describes the data source data model.
 type serviceDataSourcesAllModel struct {
-	Filter   *filterBlockModel                `tfsdk:"filter"`
-	Services []serviceMinimalaDataSourceModel `tfsdk:"services"`
+	Connective        types.String                    `tfsdk:"connective"`
+	Filter            *filterBlockModel               `tfsdk:"filter"`
+	Filters           []serviceFilterModel            `tfsdk:"filters"`
+	IncludeProperties types.Bool                      `tfsdk:"include_properties"`
+	Services          []serviceSummaryDataSourceModel `tfsdk:"services"`
+}
+
+// serviceFilterModel is one of the `filters` of the opslevel_services data source
+type serviceFilterModel struct {
+	Field  types.String `tfsdk:"field"`
+	Negate types.Bool   `tfsdk:"negate"`
+	Value  types.String `tfsdk:"value"`
 }
 
 func NewServiceDataSourcesAllModel(services []opslevel.Service) serviceDataSourcesAllModel {
-	serviceDataSourcesModel := []serviceMinimalaDataSourceModel{}
+	serviceDataSourcesModel := []serviceSummaryDataSourceModel{}
 	for _, service := range services {
-		serviceModel := serviceMinimalaDataSourceModel{
-			Id:   ComputedStringValue(string(service.Id)),
-			Name: ComputedStringValue(service.Name),
-			Url:  ComputedStringValue(service.HtmlURL),
-		}
-		serviceDataSourcesModel = append(serviceDataSourcesModel, serviceModel)
+		serviceDataSourcesModel = append(serviceDataSourcesModel, newServiceSummaryDataSourceModel(service))
 	}
 	return serviceDataSourcesAllModel{Services: serviceDataSourcesModel}
 }
@@ -47,25 +60,108 @@ func (d *ServiceDataSourcesAll) Metadata(ctx context.Context, req datasource.Met
 	resp.TypeName = req.ProviderTypeName + "_services"
 }
 
-var serviceMinimalSchemaAttrs = map[string]schema.Attribute{
+var serviceSummarySchemaAttrs = map[string]schema.Attribute{
+	"aliases": schema.ListAttribute{
+		ElementType: types.StringType,
+		Description: "The aliases of the service.",
+		Computed:    true,
+	},
+	"description": schema.StringAttribute{
+		Description: "A brief description of the service.",
+		Computed:    true,
+	},
+	"framework": schema.StringAttribute{
+		Description: "The primary software development framework that the service uses.",
+		Computed:    true,
+	},
 	"id": schema.StringAttribute{
 		Description: "The id of the service",
 		Computed:    true,
 	},
+	"language": schema.StringAttribute{
+		Description: "The primary programming language that the service is written in.",
+		Computed:    true,
+	},
+	"lifecycle_alias": schema.StringAttribute{
+		Description: "The lifecycle stage of the service.",
+		Computed:    true,
+	},
 	"name": schema.StringAttribute{
 		Description: "The display name of the service.",
 		Computed:    true,
 	},
+	"owner": schema.StringAttribute{
+		Description: "The alias of the team that owns the service.",
+		Computed:    true,
+	},
+	"owner_id": schema.StringAttribute{
+		Description: "The id of the team that owns the service.",
+		Computed:    true,
+	},
+	"product": schema.StringAttribute{
+		Description: "A product is an application that your end user interacts with. Multiple services can work together to power a single product.",
+		Computed:    true,
+	},
+	"properties": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: opslevelPropertyAttrs,
+		},
+		Description: "Custom properties assigned to the service. Only read when `include_properties` is `true`.",
+		Computed:    true,
+	},
+	"tags": schema.ListAttribute{
+		ElementType: types.StringType,
+		Description: "A list of tags applied to the service.",
+		Computed:    true,
+	},
+	"tier_alias": schema.StringAttribute{
+		Description: "The software tier that the service belongs to.",
+		Computed:    true,
+	},
 	"url": schema.StringAttribute{
 		Description: "A link to the HTML page for the resource",
 		Computed:    true,
 	},
 }
 
-type serviceMinimalaDataSourceModel struct {
-	Id   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
-	Url  types.String `tfsdk:"url"`
+type serviceSummaryDataSourceModel struct {
+	Aliases        types.List      `tfsdk:"aliases"`
+	Description    types.String    `tfsdk:"description"`
+	Framework      types.String    `tfsdk:"framework"`
+	Id             types.String    `tfsdk:"id"`
+	Language       types.String    `tfsdk:"language"`
+	LifecycleAlias types.String    `tfsdk:"lifecycle_alias"`
+	Name           types.String    `tfsdk:"name"`
+	Owner          types.String    `tfsdk:"owner"`
+	OwnerId        types.String    `tfsdk:"owner_id"`
+	Product        types.String    `tfsdk:"product"`
+	Properties     []propertyModel `tfsdk:"properties"`
+	Tags           types.List      `tfsdk:"tags"`
+	TierAlias      types.String    `tfsdk:"tier_alias"`
+	Url            types.String    `tfsdk:"url"`
+}
+
+func newServiceSummaryDataSourceModel(service opslevel.Service) serviceSummaryDataSourceModel {
+	serviceModel := serviceSummaryDataSourceModel{
+		Aliases:        OptionalStringListValue(service.Aliases),
+		Description:    ComputedStringValue(service.Description),
+		Framework:      ComputedStringValue(service.Framework),
+		Id:             ComputedStringValue(string(service.Id)),
+		Language:       ComputedStringValue(service.Language),
+		LifecycleAlias: ComputedStringValue(service.Lifecycle.Alias),
+		Name:           ComputedStringValue(service.Name),
+		Owner:          ComputedStringValue(service.Owner.Alias),
+		OwnerId:        ComputedStringValue(string(service.Owner.Id)),
+		Product:        ComputedStringValue(service.Product),
+		TierAlias:      ComputedStringValue(service.Tier.Alias),
+		Url:            ComputedStringValue(service.HtmlURL),
+	}
+	if service.Tags == nil {
+		serviceModel.Tags = types.ListNull(types.StringType)
+	} else {
+		serviceModel.Tags = OptionalStringListValue(flattenTagArray(service.Tags.Nodes))
+	}
+	return serviceModel
 }
 
 func (d *ServiceDataSourcesAll) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -74,6 +170,14 @@ func (d *ServiceDataSourcesAll) Schema(ctx context.Context, req datasource.Schem
 		MarkdownDescription: "Services data source",
 
 		Attributes: map[string]schema.Attribute{
+			"connective": schema.StringAttribute{
+				Description: fmt.Sprintf(
+					"How `filters` are combined, a service must match all of them with `and` or any of them with `or`. One of `%s`. Defaults to `and`.",
+					strings.Join(opslevel.AllConnectiveEnum, "`, `"),
+				),
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.OneOf(opslevel.AllConnectiveEnum...)},
+			},
 			"filter": schema.SingleNestedAttribute{
 				Description: fmt.Sprintf(
 					"Used to filter services by one of '%s'",
@@ -81,10 +185,28 @@ func (d *ServiceDataSourcesAll) Schema(ctx context.Context, req datasource.Schem
 				),
 				Optional:   true,
 				Attributes: FilterAttrs(validFieldNames),
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("filters")),
+				},
+			},
+			"filters": schema.ListNestedAttribute{
+				Description: fmt.Sprintf(
+					"Used to filter services by several of '%s', combined with `connective`",
+					strings.Join(validFieldNames, "`, `"),
+				),
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: serviceFilterAttrs(validFieldNames),
+				},
+				Validators: []validator.List{listvalidator.SizeAtLeast(1)},
+			},
+			"include_properties": schema.BoolAttribute{
+				Description: "Also read the custom properties of each service, which takes one request per service. Defaults to `false`.",
+				Optional:    true,
 			},
 			"services": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: serviceMinimalSchemaAttrs,
+					Attributes: serviceSummarySchemaAttrs,
 				},
 				Description: "List of Service data sources",
 				Computed:    true,
@@ -93,75 +215,285 @@ func (d *ServiceDataSourcesAll) Schema(ctx context.Context, req datasource.Schem
 	}
 }
 
+func serviceFilterAttrs(validFieldNames []string) map[string]schema.Attribute {
+	filterAttrs := FilterAttrs(validFieldNames)
+	filterAttrs["negate"] = schema.BoolAttribute{
+		Description: "Match the services that don't match this filter instead. Defaults to `false`.",
+		Optional:    true,
+	}
+	return filterAttrs
+}
+
 func (d *ServiceDataSourcesAll) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	planModel := read[serviceDataSourcesAllModel](ctx, &resp.Diagnostics, req.Config)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	var services []opslevel.Service
+	if len(planModel.Filters) > 0 {
+		services = d.listServicesWithFilters(planModel.Filters, planModel.Connective.ValueString(), &resp.Diagnostics)
+	} else {
+		services = listServicesWithFilter(d.client, planModel.Filter, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateModel := NewServiceDataSourcesAllModel(services)
+	stateModel.Connective = planModel.Connective
+	stateModel.Filter = planModel.Filter
+	stateModel.Filters = planModel.Filters
+	stateModel.IncludeProperties = planModel.IncludeProperties
+
+	if planModel.IncludeProperties.ValueBool() {
+		for i, service := range services {
+			properties, err := service.GetProperties(d.client, nil)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("services").AtListIndex(i).AtName("properties"),
+					"OpsLevel Client Error",
+					fmt.Sprintf("unable to read Properties for service '%s', got error: %s", service.Name, err),
+				)
+				continue
+			}
+			if properties != nil {
+				var diags diag.Diagnostics
+				stateModel.Services[i].Properties, diags = NewPropertiesAllModel(ctx, properties.Nodes)
+				resp.Diagnostics.Append(diags...)
+			}
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Save data into Terraform state
+	tflog.Trace(ctx, "listed all OpsLevel Service data sources")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+}
+
+// listServicesWithFilters lists the services matching several filters. Filters the API can evaluate are sent
+// together as one ServiceFilterInput, the others are listed one by one and combined with it in the provider.
+func (d *ServiceDataSourcesAll) listServicesWithFilters(filters []serviceFilterModel, connective string, diags *diag.Diagnostics) []opslevel.Service {
+	if connective == "" {
+		connective = string(opslevel.ConnectiveEnumAnd)
+	}
+
+	var predicates []opslevel.ServiceFilterInput
+	var matches []serviceMatches
+	negated := false
+	for _, filter := range filters {
+		predicate, err := d.serviceFilterPredicate(filter)
+		if err != nil {
+			diags.AddError("Config Error", fmt.Sprintf("Unable to filter services by %s '%s', got error: %s", filter.Field.ValueString(), filter.Value.ValueString(), err))
+			return nil
+		}
+		if predicate != nil {
+			predicates = append(predicates, *predicate)
+			continue
+		}
+
+		services := listServicesWithFilter(d.client, &filterBlockModel{Field: filter.Field, Value: filter.Value}, diags)
+		if diags.HasError() {
+			return nil
+		}
+		matches = append(matches, serviceMatches{services: services, negate: filter.Negate.ValueBool()})
+		negated = negated || filter.Negate.ValueBool()
+	}
+
+	if len(predicates) > 0 {
+		services, err := d.client.ListServicesWithInputFilter(opslevel.ServiceFilterInput{
+			Connective: getConnectiveEnum(connective),
+			Predicates: &predicates,
+		}, nil)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to list services, got error: %s", err))
+			return nil
+		}
+		if services == nil {
+			services = &opslevel.ServiceConnection{}
+		}
+		if len(matches) == 0 {
+			return services.Nodes
+		}
+		matches = append([]serviceMatches{{services: services.Nodes}}, matches...)
+	}
+
+	// a negated filter matches services no filter returned, so every service is a candidate
+	var all []opslevel.Service
+	if negated {
+		all = listServicesWithFilter(d.client, nil, diags)
+		if diags.HasError() {
+			return nil
+		}
+	}
+	return combineServiceMatches(connective, matches, all)
+}
+
+// serviceFilterPredicate returns the ServiceFilterInput for a filter, or nil when the API can't evaluate it.
+// Tag filters are left to ListServicesWithTag, which parses `key:value` the way the single `filter` always has.
+func (d *ServiceDataSourcesAll) serviceFilterPredicate(filter serviceFilterModel) (*opslevel.ServiceFilterInput, error) {
+	predicate := opslevel.ServiceFilterInput{
+		Arg:  filter.Value.ValueString(),
+		Type: &opslevel.BasicTypeEnumEquals,
+	}
+	if filter.Negate.ValueBool() {
+		predicate.Type = &opslevel.BasicTypeEnumDoesNotEqual
+	}
+
+	switch filter.Field.ValueString() {
+	case "component_type":
+		predicate.Key = &opslevel.ServiceFilterEnumComponentTypeID
+	case "filter":
+		if !opslevel.IsID(predicate.Arg) {
+			return nil, fmt.Errorf("'value' must be a valid filter ID")
+		}
+		predicate.Key = &opslevel.ServiceFilterEnumFilterID
+	case "framework":
+		predicate.Key = &opslevel.ServiceFilterEnumFramework
+	case "language":
+		predicate.Key = &opslevel.ServiceFilterEnumLanguage
+	case "product":
+		predicate.Key = &opslevel.ServiceFilterEnumProduct
+	case "owner":
+		teamId, err := d.cache.resolveTeamID(d.client, predicate.Arg)
+		if err != nil {
+			return nil, err
+		}
+		predicate.Key = &opslevel.ServiceFilterEnumOwnerID
+		predicate.Arg = string(teamId)
+	case "lifecycle":
+		lifecycles, err := d.cache.listLifecycles(d.client)
+		if err != nil {
+			return nil, err
+		}
+		index := slices.IndexFunc(lifecycles, func(lifecycle opslevel.Lifecycle) bool { return lifecycle.Alias == predicate.Arg })
+		if index == -1 {
+			return nil, fmt.Errorf("lifecycle with alias '%s' not found", predicate.Arg)
+		}
+		predicate.Key = &opslevel.ServiceFilterEnumLifecycleIndex
+		predicate.Arg = strconv.Itoa(lifecycles[index].Index)
+	case "tier":
+		tiers, err := d.cache.listTiers(d.client)
+		if err != nil {
+			return nil, err
+		}
+		index := slices.IndexFunc(tiers, func(tier opslevel.Tier) bool { return tier.Alias == predicate.Arg })
+		if index == -1 {
+			return nil, fmt.Errorf("tier with alias '%s' not found", predicate.Arg)
+		}
+		predicate.Key = &opslevel.ServiceFilterEnumTierIndex
+		predicate.Arg = strconv.Itoa(tiers[index].Index)
+	default:
+		return nil, nil
+	}
+	return &predicate, nil
+}
+
+// serviceMatches are the services one or more filters matched, in the order the API returned them
+type serviceMatches struct {
+	services []opslevel.Service
+	negate   bool
+}
+
+// combineServiceMatches keeps the services that match all (`and`) or any (`or`) of matches.
+// all is every service in the account, only needed when one of matches is negated.
+func combineServiceMatches(connective string, matches []serviceMatches, all []opslevel.Service) []opslevel.Service {
+	candidates := all
+	if candidates == nil {
+		seen := map[opslevel.ID]bool{}
+		for _, match := range matches {
+			for _, service := range match.services {
+				if !seen[service.Id] {
+					seen[service.Id] = true
+					candidates = append(candidates, service)
+				}
+			}
+		}
+	}
+
+	matchedIds := make([]map[opslevel.ID]bool, len(matches))
+	for i, match := range matches {
+		matchedIds[i] = map[opslevel.ID]bool{}
+		for _, service := range match.services {
+			matchedIds[i][service.Id] = true
+		}
+	}
+
+	output := []opslevel.Service{}
+	for _, service := range candidates {
+		matchesAll, matchesAny := true, false
+		for i, match := range matches {
+			matched := matchedIds[i][service.Id] != match.negate
+			matchesAll = matchesAll && matched
+			matchesAny = matchesAny || matched
+		}
+		if (connective == string(opslevel.ConnectiveEnumOr) && matchesAny) || (connective != string(opslevel.ConnectiveEnumOr) && matchesAll) {
+			output = append(output, service)
+		}
+	}
+	return output
+}
+
+// listServicesWithFilter lists the services matching a filter block, or every service when filter is nil.
+// Shared by the opslevel_services data source and the opslevel_service list resource.
+func listServicesWithFilter(client *opslevel.Client, filter *filterBlockModel, d *diag.Diagnostics) []opslevel.Service {
 	var services *opslevel.ServiceConnection
 	var err error
 
-	if planModel.Filter == nil {
-		services, err = d.client.ListServices(nil)
+	if filter == nil {
+		services, err = client.ListServices(nil)
 	} else {
-		switch planModel.Filter.Field.ValueString() {
+		switch filter.Field.ValueString() {
 		case "component_type":
-			componentTypeValue := planModel.Filter.Value.ValueString()
+			componentTypeValue := filter.Value.ValueString()
 			filterInput := opslevel.ServiceFilterInput{
 				Key: &opslevel.ServiceFilterEnumComponentTypeID,
 				Arg: componentTypeValue,
 			}
-			services, err = d.client.ListServicesWithInputFilter(filterInput, nil)
+			services, err = client.ListServicesWithInputFilter(filterInput, nil)
 		case "filter":
-			filterId := planModel.Filter.Value.ValueString()
-			if opslevel.IsID(filterId) {
-				services, err = d.client.ListServicesWithFilter(filterId, nil)
-			} else {
-				resp.Diagnostics.AddError("Config Error",
+			filterId := filter.Value.ValueString()
+			if !opslevel.IsID(filterId) {
+				d.AddError("Config Error",
 					fmt.Sprintf("'value' field in filter block must be a valid ID. Given '%s'", filterId),
 				)
+				return nil
 			}
+			services, err = client.ListServicesWithFilter(filterId, nil)
 		case "framework":
-			services, err = d.client.ListServicesWithFramework(planModel.Filter.Value.ValueString(), nil)
+			services, err = client.ListServicesWithFramework(filter.Value.ValueString(), nil)
 		case "language":
-			services, err = d.client.ListServicesWithLanguage(planModel.Filter.Value.ValueString(), nil)
+			services, err = client.ListServicesWithLanguage(filter.Value.ValueString(), nil)
 		case "lifecycle":
-			services, err = d.client.ListServicesWithLifecycle(planModel.Filter.Value.ValueString(), nil)
+			services, err = client.ListServicesWithLifecycle(filter.Value.ValueString(), nil)
 		case "owner":
-			services, err = d.client.ListServicesWithOwner(planModel.Filter.Value.ValueString(), nil)
+			services, err = client.ListServicesWithOwner(filter.Value.ValueString(), nil)
 		case "product":
-			services, err = d.client.ListServicesWithProduct(planModel.Filter.Value.ValueString(), nil)
+			services, err = client.ListServicesWithProduct(filter.Value.ValueString(), nil)
 		case "tag":
-			tagArgs, tagArgsErr := opslevel.NewTagArgs(planModel.Filter.Value.ValueString())
+			tagArgs, tagArgsErr := opslevel.NewTagArgs(filter.Value.ValueString())
 			if tagArgsErr != nil {
-				resp.Diagnostics.AddError("Client Error",
-					fmt.Sprintf("Unable to create TagArgs from '%s', got error: '%s'", planModel.Filter.Value.ValueString(), err),
+				d.AddError("Client Error",
+					fmt.Sprintf("Unable to create TagArgs from '%s', got error: '%s'", filter.Value.ValueString(), tagArgsErr),
 				)
-				return
+				return nil
 			}
-			services, err = d.client.ListServicesWithTag(tagArgs, nil)
+			services, err = client.ListServicesWithTag(tagArgs, nil)
 		case "tier":
-			services, err = d.client.ListServicesWithTier(planModel.Filter.Value.ValueString(), nil)
+			services, err = client.ListServicesWithTier(filter.Value.ValueString(), nil)
 		default:
-			services, err = d.client.ListServices(nil)
+			services, err = client.ListServices(nil)
 		}
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list services datasource, got error: %s", err))
-		return
+		d.AddError("Client Error", fmt.Sprintf("Unable to list services, got error: %s", err))
+		return nil
 	}
-
-	var stateModel serviceDataSourcesAllModel
 	if services == nil {
-		stateModel = NewServiceDataSourcesAllModel([]opslevel.Service{})
-	} else {
-		stateModel = NewServiceDataSourcesAllModel(services.Nodes)
+		return []opslevel.Service{}
 	}
-	stateModel.Filter = planModel.Filter
-
-	// Save data into Terraform state
-	tflog.Trace(ctx, "listed all OpsLevel Service data sources")
-	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	return services.Nodes
 }
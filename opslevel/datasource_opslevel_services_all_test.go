@@ -0,0 +1,46 @@
+package opslevel
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+func TestCombineServiceMatches(t *testing.T) {
+	service := func(id string) opslevel.Service {
+		var service opslevel.Service
+		service.Id = opslevel.ID(id)
+		return service
+	}
+	api, web, worker := service("api"), service("web"), service("worker")
+	all := []opslevel.Service{api, web, worker}
+	goServices := serviceMatches{services: []opslevel.Service{api, worker}}
+	pciServices := serviceMatches{services: []opslevel.Service{worker, web}}
+	notPciServices := serviceMatches{services: pciServices.services, negate: true}
+
+	tests := map[string]struct {
+		connective string
+		matches    []serviceMatches
+		all        []opslevel.Service
+		expected   []opslevel.ID
+	}{
+		"and keeps services in every match":        {connective: "and", matches: []serviceMatches{goServices, pciServices}, expected: []opslevel.ID{"worker"}},
+		"empty connective defaults to and":         {connective: "", matches: []serviceMatches{goServices, pciServices}, expected: []opslevel.ID{"worker"}},
+		"or keeps services in any match":           {connective: "or", matches: []serviceMatches{goServices, pciServices}, expected: []opslevel.ID{"api", "worker", "web"}},
+		"negated match excludes services":          {connective: "and", matches: []serviceMatches{goServices, notPciServices}, all: all, expected: []opslevel.ID{"api"}},
+		"or with negated match uses every service": {connective: "or", matches: []serviceMatches{notPciServices}, all: all, expected: []opslevel.ID{"api"}},
+		"no matching services":                     {connective: "and", matches: []serviceMatches{{}, goServices}, expected: []opslevel.ID{}},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ids := []opslevel.ID{}
+			for _, service := range combineServiceMatches(tc.connective, tc.matches, tc.all) {
+				ids = append(ids, service.Id)
+			}
+			if !slices.Equal(ids, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, ids)
+			}
+		})
+	}
+}
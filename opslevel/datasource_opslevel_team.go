@@ -243,16 +243,20 @@ func (teamDataSource *TeamDataSource) Read(ctx context.Context, req datasource.R
 		return
 	}
 
-	var err error
-	var team *opslevel.Team
+	var teamIdentifier string
 	if data.Alias.ValueString() != "" {
-		team, err = teamDataSource.client.GetTeamWithAlias(data.Alias.ValueString())
+		teamIdentifier = data.Alias.ValueString()
 	} else if opslevel.IsID(data.Id.ValueString()) {
-		team, err = teamDataSource.client.GetTeam(opslevel.ID(data.Id.ValueString()))
+		teamIdentifier = data.Id.ValueString()
 	} else {
 		resp.Diagnostics.AddError("Config Error", "'alias' or 'id' for opslevel_team datasource must be set")
 		return
 	}
+	var team *opslevel.Team
+	teamId, err := teamDataSource.cache.resolveTeamID(teamDataSource.client, teamIdentifier)
+	if err == nil {
+		team, err = teamDataSource.client.GetTeam(teamId)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("unable to read team, got error: %s", err))
 		return
@@ -99,7 +99,7 @@ func (d *TierDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	tiers, err := d.client.ListTiers()
+	tiers, err := d.cache.listTiers(d.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read tier datasource, got error: %s", err))
 		return
@@ -54,7 +54,7 @@ func (d *TierDataSourcesAll) Schema(ctx context.Context, req datasource.SchemaRe
 }
 
 func (d *TierDataSourcesAll) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	tiers, err := d.client.ListTiers()
+	tiers, err := d.cache.listTiers(d.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list tiers, got error: %s", err))
 		return
@@ -0,0 +1,112 @@
+package opslevel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+// Ensure SecretEphemeralResource implements EphemeralResourceWithConfigure interface
+var _ ephemeral.EphemeralResourceWithConfigure = &SecretEphemeralResource{}
+
+func NewSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &SecretEphemeralResource{}
+}
+
+// SecretEphemeralResource reads a Secret without persisting its value to plan or state.
+type SecretEphemeralResource struct {
+	CommonEphemeralResourceClient
+}
+
+// secretEphemeralResourceModel describes the ephemeral resource data model.
+type secretEphemeralResourceModel struct {
+	Alias      types.String `tfsdk:"alias"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	Id         types.String `tfsdk:"id"`
+	Identifier types.String `tfsdk:"identifier"`
+	Owner      types.String `tfsdk:"owner"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+	Value      types.String `tfsdk:"value"`
+}
+
+func newSecretEphemeralResourceModel(secret opslevel.Secret, identifier types.String) secretEphemeralResourceModel {
+	return secretEphemeralResourceModel{
+		Alias:      ComputedStringValue(secret.Alias),
+		CreatedAt:  ComputedStringValue(secret.Timestamps.CreatedAt.Local().Format(time.RFC850)),
+		Id:         ComputedStringValue(string(secret.Id)),
+		Identifier: identifier,
+		Owner:      ComputedStringValue(string(secret.Owner.Id)),
+		UpdatedAt:  ComputedStringValue(secret.Timestamps.UpdatedAt.Local().Format(time.RFC850)),
+		Value:      types.StringValue(secret.Value),
+	}
+}
+
+func (e *SecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret"
+}
+
+func (e *SecretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Secret ephemeral resource. Reads a secret stored in OpsLevel without saving its value to the plan or state.",
+
+		Attributes: map[string]schema.Attribute{
+			"alias": schema.StringAttribute{
+				Description: "The alias of the secret.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp of time created at.",
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The ID of the secret.",
+				Computed:    true,
+			},
+			"identifier": schema.StringAttribute{
+				Description: "The id or alias of the secret to read.",
+				Required:    true,
+			},
+			"owner": schema.StringAttribute{
+				Description: "The id of the team that owns the secret.",
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Timestamp of last update.",
+				Computed:    true,
+			},
+			"value": schema.StringAttribute{
+				Description: "The sensitive value of the secret.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (e *SecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	data := read[secretEphemeralResourceModel](ctx, &resp.Diagnostics, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, err := e.client.GetSecret(data.Identifier.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read secret '%s', got error: %s", data.Identifier.ValueString(), err))
+		return
+	}
+	if secret == nil || secret.Id == "" {
+		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to find secret '%s'", data.Identifier.ValueString()))
+		return
+	}
+	secretModel := newSecretEphemeralResourceModel(*secret, data.Identifier)
+
+	tflog.Trace(ctx, "opened a secret ephemeral resource")
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &secretModel)...)
+}
@@ -0,0 +1,70 @@
+package opslevel
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcceptanceSecretEphemeralResource(t *testing.T) {
+	api := newFakeAPI(t)
+	teamId := api.Seed("team", map[string]any{"name": "Platform"})
+	secretId := api.Seed("secretsVaultsSecret", map[string]any{
+		"alias":      "db_password",
+		"value":      "hunter2",
+		"ownerId":    teamId,
+		"timestamps": map[string]any{"createdAt": "2026-01-02T03:04:05Z", "updatedAt": "2026-02-03T04:05:06Z"},
+	})
+	// the echo provider stores what it is configured with, the only way to see an ephemeral value from a test
+	config := func(identifier string) string {
+		return providerConfig(api, fmt.Sprintf(`
+ephemeral "opslevel_secret" "test" {
+  identifier = %q
+}
+
+provider "echo" {
+  data = {
+    id    = ephemeral.opslevel_secret.test.id
+    owner = ephemeral.opslevel_secret.test.owner
+    value = ephemeral.opslevel_secret.test.value
+  }
+}
+
+resource "echo" "test" {}
+`, identifier))
+	}
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"opslevel": testAccProtoV6ProviderFactories["opslevel"],
+			"echo":     echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("db_password"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data"), knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"id":    knownvalue.StringExact(secretId),
+						"owner": knownvalue.StringExact(teamId),
+						"value": knownvalue.StringExact("hunter2"),
+					})),
+				},
+			},
+			{
+				Config:      config("missing"),
+				ExpectError: regexp.MustCompile(`Unable to (find|read) secret 'missing'`),
+			},
+		},
+	})
+}
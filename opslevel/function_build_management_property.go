@@ -0,0 +1,70 @@
+package opslevel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &BuildManagementPropertyFunction{}
+
+func NewBuildManagementPropertyFunction() function.Function {
+	return &BuildManagementPropertyFunction{}
+}
+
+// BuildManagementPropertyFunction builds the property string sent to OpsLevel for a component type management rule
+type BuildManagementPropertyFunction struct{}
+
+func (f *BuildManagementPropertyFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_management_property"
+}
+
+func (f *BuildManagementPropertyFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds a management rule property string",
+		MarkdownDescription: "Builds the property string OpsLevel uses in component type management rules, such as `tag_key_eq:owner`. Properties other than `tag` are returned unchanged.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "property",
+				Description: "The property to match on, such as 'name', 'alias' or 'tag'.",
+			},
+			function.StringParameter{
+				Name:           "tag_key",
+				Description:    "The tag key to match. Required when property is 'tag', must be null otherwise.",
+				AllowNullValue: true,
+			},
+			function.StringParameter{
+				Name:           "tag_operation",
+				Description:    "Either 'equals' or 'starts_with'. Defaults to 'equals' when null. Only used when property is 'tag'.",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *BuildManagementPropertyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var property string
+	var tagKey, tagOperation types.String
+	resp.Error = req.Arguments.Get(ctx, &property, &tagKey, &tagOperation)
+	if resp.Error != nil {
+		return
+	}
+
+	if property == "tag" && tagKey.ValueString() == "" {
+		resp.Error = function.NewArgumentFuncError(1, "tag_key is required when property is 'tag'")
+		return
+	}
+	if property != "tag" && !tagKey.IsNull() {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("tag_key must be null when property is '%s'", property))
+		return
+	}
+	if operation := tagOperation.ValueString(); operation != "" && operation != "equals" && operation != "starts_with" {
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("tag_operation must be either 'equals' or 'starts_with', got '%s'", operation))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, BuildPropertyString(property, tagKey.ValueString(), tagOperation.ValueString()))
+}
@@ -0,0 +1,56 @@
+package opslevel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+var _ function.Function = &FormatTagFunction{}
+
+func NewFormatTagFunction() function.Function {
+	return &FormatTagFunction{}
+}
+
+// FormatTagFunction joins a key and value into a `key:value` tag
+type FormatTagFunction struct{}
+
+func (f *FormatTagFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "format_tag"
+}
+
+func (f *FormatTagFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Formats a key and value as a tag",
+		MarkdownDescription: "Joins a key and value into a tag in the `key:value` format used by the `tags` attribute of `opslevel_service`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "key",
+				Description: "The tag key.",
+			},
+			function.StringParameter{
+				Name:        "value",
+				Description: "The tag value.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FormatTagFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var key, value string
+	resp.Error = req.Arguments.Get(ctx, &key, &value)
+	if resp.Error != nil {
+		return
+	}
+
+	tag := flattenTag(opslevel.Tag{Key: key, Value: value})
+	if !hasTagFormat(tag) {
+		resp.Error = function.NewFuncError(fmt.Sprintf("'%s' is not a valid tag, the key and value must be non-empty and must not contain ':'", tag))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, tag)
+}
@@ -0,0 +1,45 @@
+package opslevel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+var _ function.Function = &IsIdFunction{}
+
+func NewIsIdFunction() function.Function {
+	return &IsIdFunction{}
+}
+
+// IsIdFunction reports whether a string is an OpsLevel ID rather than an alias
+type IsIdFunction struct{}
+
+func (f *IsIdFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "is_id"
+}
+
+func (f *IsIdFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Checks whether a value is an OpsLevel ID",
+		Description: "Returns true if the given value is an OpsLevel ID and false if it is anything else, such as an alias.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "value",
+				Description: "The ID or alias to check.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *IsIdFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	resp.Error = req.Arguments.Get(ctx, &value)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, opslevel.IsID(value))
+}
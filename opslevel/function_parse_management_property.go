@@ -0,0 +1,63 @@
+package opslevel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ParseManagementPropertyFunction{}
+
+func NewParseManagementPropertyFunction() function.Function {
+	return &ParseManagementPropertyFunction{}
+}
+
+// ParseManagementPropertyFunction splits a component type management rule property string into its parts
+type ParseManagementPropertyFunction struct{}
+
+type parseManagementPropertyResultModel struct {
+	Property     types.String `tfsdk:"property"`
+	TagKey       types.String `tfsdk:"tag_key"`
+	TagOperation types.String `tfsdk:"tag_operation"`
+}
+
+func (f *ParseManagementPropertyFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_management_property"
+}
+
+func (f *ParseManagementPropertyFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parses a management rule property string",
+		MarkdownDescription: "Splits a property string from a component type management rule, such as `tag_key_eq:owner`, into an object with `property`, `tag_key` and `tag_operation` attributes. `tag_key` and `tag_operation` are null unless the property is `tag`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "value",
+				Description: "The property string to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"property":      types.StringType,
+				"tag_key":       types.StringType,
+				"tag_operation": types.StringType,
+			},
+		},
+	}
+}
+
+func (f *ParseManagementPropertyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	resp.Error = req.Arguments.Get(ctx, &value)
+	if resp.Error != nil {
+		return
+	}
+
+	property, tagKey, tagOperation := ParsePropertyString(value)
+	resp.Error = resp.Result.Set(ctx, parseManagementPropertyResultModel{
+		Property:     types.StringValue(property),
+		TagKey:       OptionalStringValue(tagKey),
+		TagOperation: OptionalStringValue(tagOperation),
+	})
+}
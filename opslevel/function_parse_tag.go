@@ -0,0 +1,67 @@
+package opslevel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ParseTagFunction{}
+
+func NewParseTagFunction() function.Function {
+	return &ParseTagFunction{}
+}
+
+// ParseTagFunction splits a `key:value` tag into its key and value
+type ParseTagFunction struct{}
+
+type parseTagResultModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+func (f *ParseTagFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_tag"
+}
+
+func (f *ParseTagFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parses a tag into its key and value",
+		MarkdownDescription: "Splits a tag in the `key:value` format used by the `tags` attribute of `opslevel_service` into an object with `key` and `value` attributes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "tag",
+				MarkdownDescription: "The tag to parse, formatted as `key:value`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"key":   types.StringType,
+				"value": types.StringType,
+			},
+		},
+	}
+}
+
+func (f *ParseTagFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var tag string
+	resp.Error = req.Arguments.Get(ctx, &tag)
+	if resp.Error != nil {
+		return
+	}
+
+	if !hasTagFormat(tag) {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("'%s' is not a tag, expected the format 'key:value'", tag))
+		return
+	}
+
+	parts := strings.Split(tag, ":")
+	resp.Error = resp.Result.Set(ctx, parseTagResultModel{
+		Key:   types.StringValue(parts[0]),
+		Value: types.StringValue(parts[1]),
+	})
+}
@@ -0,0 +1,114 @@
+package opslevel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// runFunction calls a provider function the way Terraform does and returns its result
+func runFunction(t *testing.T, f function.Function, args ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+	ctx := context.Background()
+
+	definitionResp := function.DefinitionResponse{}
+	f.Definition(ctx, function.DefinitionRequest{}, &definitionResp)
+
+	result, funcErr := definitionResp.Definition.Return.NewResultData(ctx)
+	if funcErr != nil {
+		t.Fatalf("unable to create result data: %s", funcErr)
+	}
+	resp := function.RunResponse{Result: result}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(args)}, &resp)
+	return resp.Result.Value(), resp.Error
+}
+
+func TestParseTagFunction(t *testing.T) {
+	result, err := runFunction(t, NewParseTagFunction(), types.StringValue("env:prod"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	attrs := result.(types.Object).Attributes()
+	if !attrs["key"].Equal(types.StringValue("env")) || !attrs["value"].Equal(types.StringValue("prod")) {
+		t.Errorf("expected key 'env' and value 'prod', got %s", result)
+	}
+
+	if _, err := runFunction(t, NewParseTagFunction(), types.StringValue("env")); err == nil {
+		t.Error("expected an error for a tag without a value")
+	}
+}
+
+func TestFormatTagFunction(t *testing.T) {
+	result, err := runFunction(t, NewFormatTagFunction(), types.StringValue("env"), types.StringValue("prod"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !result.Equal(types.StringValue("env:prod")) {
+		t.Errorf("expected 'env:prod', got %s", result)
+	}
+
+	if _, err := runFunction(t, NewFormatTagFunction(), types.StringValue("env:x"), types.StringValue("prod")); err == nil {
+		t.Error("expected an error for a key containing ':'")
+	}
+}
+
+func TestBuildManagementPropertyFunction(t *testing.T) {
+	testCases := []struct {
+		name         string
+		property     string
+		tagKey       types.String
+		tagOperation types.String
+		expected     string
+		expectError  bool
+	}{
+		{name: "builtin property", property: "name", tagKey: types.StringNull(), tagOperation: types.StringNull(), expected: "name"},
+		{name: "tag defaults to equals", property: "tag", tagKey: types.StringValue("owner"), tagOperation: types.StringNull(), expected: "tag_key_eq:owner"},
+		{name: "tag starts with", property: "tag", tagKey: types.StringValue("team"), tagOperation: types.StringValue("starts_with"), expected: "tag_key_starts_with:team"},
+		{name: "tag without key", property: "tag", tagKey: types.StringNull(), tagOperation: types.StringNull(), expectError: true},
+		{name: "key without tag", property: "name", tagKey: types.StringValue("owner"), tagOperation: types.StringNull(), expectError: true},
+		{name: "unknown operation", property: "tag", tagKey: types.StringValue("owner"), tagOperation: types.StringValue("contains"), expectError: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := runFunction(t, NewBuildManagementPropertyFunction(), types.StringValue(testCase.property), testCase.tagKey, testCase.tagOperation)
+			if testCase.expectError {
+				if err == nil {
+					t.Errorf("expected an error, got %s", result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !result.Equal(types.StringValue(testCase.expected)) {
+				t.Errorf("expected '%s', got %s", testCase.expected, result)
+			}
+		})
+	}
+}
+
+func TestParseManagementPropertyFunction(t *testing.T) {
+	result, err := runFunction(t, NewParseManagementPropertyFunction(), types.StringValue("tag_key_starts_with:team"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	attrs := result.(types.Object).Attributes()
+	if !attrs["property"].Equal(types.StringValue("tag")) ||
+		!attrs["tag_key"].Equal(types.StringValue("team")) ||
+		!attrs["tag_operation"].Equal(types.StringValue("starts_with")) {
+		t.Errorf("unexpected result %s", result)
+	}
+
+	result, err = runFunction(t, NewParseManagementPropertyFunction(), types.StringValue("alias"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	attrs = result.(types.Object).Attributes()
+	if !attrs["property"].Equal(types.StringValue("alias")) || !attrs["tag_key"].IsNull() || !attrs["tag_operation"].IsNull() {
+		t.Errorf("unexpected result %s", result)
+	}
+}
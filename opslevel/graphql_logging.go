@@ -0,0 +1,183 @@
+package opslevel
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// graphQLLogSubsystem logs every GraphQL operation at TRACE level.
+// Enable it with TF_LOG_PROVIDER_OPSLEVEL_GRAPHQL=TRACE.
+const graphQLLogSubsystem = "opslevel-graphql"
+
+const redactedValue = "***"
+
+// graphQLOperationName matches the name of a named query or mutation
+var graphQLOperationName = regexp.MustCompile(`^\s*(query|mutation)\s+(\w+)`)
+
+// redactedFragments mark variables whose values must never be logged. `value` is redacted as a whole key
+// because it holds secret values, tag values and property values which may all be sensitive.
+var redactedFragments = []string{"secret", "token", "password", "credential", "private"}
+
+func newGraphQLLogger(ctx context.Context) context.Context {
+	return tflog.NewSubsystem(ctx, graphQLLogSubsystem,
+		tflog.WithLevelFromEnv("TF_LOG_PROVIDER_OPSLEVEL_GRAPHQL"),
+		// keeps tf_resource_type, tf_rpc and tf_req_id. Terraform doesn't send providers the resource address,
+		// so operations are traced back to a resource through tf_req_id in Terraform's own log.
+		tflog.WithRootFields(),
+	)
+}
+
+// graphQLLogTransport logs the operation name, redacted variables, duration and errors of every GraphQL request.
+// The context is the one the resource, data source or provider was configured with, or the one of a resource operation
+// bounded by its `timeouts`. Requests are cancelled once it is done.
+type graphQLLogTransport struct {
+	base http.RoundTripper
+	ctx  context.Context
+}
+
+func newGraphQLLogTransport(ctx context.Context, base http.RoundTripper) *graphQLLogTransport {
+	return &graphQLLogTransport{base: base, ctx: newGraphQLLogger(ctx)}
+}
+
+func (t *graphQLLogTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return t.base.RoundTrip(req)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	reqCtx, cancel := context.WithCancel(req.Context())
+	defer cancel()
+	defer context.AfterFunc(t.ctx, cancel)()
+	logReq := req.Clone(reqCtx)
+	logReq.Body = io.NopCloser(bytes.NewReader(body))
+	logReq.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	fields := graphQLRequestFields(body)
+	start := time.Now()
+	resp, err := t.base.RoundTrip(logReq)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemTrace(t.ctx, graphQLLogSubsystem, "GraphQL request failed", fields)
+		return resp, err
+	}
+
+	fields["status"] = resp.StatusCode
+	responseBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+	if err != nil {
+		return resp, err
+	}
+	if messages := graphQLResponseErrors(responseBody); len(messages) > 0 {
+		fields["errors"] = messages
+	}
+	tflog.SubsystemTrace(t.ctx, graphQLLogSubsystem, "GraphQL request", fields)
+	return resp, nil
+}
+
+func graphQLRequestFields(body []byte) map[string]any {
+	var request struct {
+		Query         string         `json:"query"`
+		OperationName string         `json:"operationName"`
+		Variables     map[string]any `json:"variables"`
+	}
+	if err := json.Unmarshal(body, &request); err != nil {
+		return map[string]any{"operation": "unknown"}
+	}
+
+	operation := request.OperationName
+	if operation == "" {
+		if match := graphQLOperationName.FindStringSubmatch(request.Query); match != nil {
+			operation = match[2]
+		} else {
+			operation = "anonymous"
+		}
+	}
+	return map[string]any{
+		"operation": operation,
+		"variables": redactVariables(request.Variables),
+	}
+}
+
+// redactVariables returns a copy of GraphQL variables with the values of sensitive keys masked
+func redactVariables(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		output := make(map[string]any, len(typed))
+		for key, item := range typed {
+			if isRedactedKey(key) {
+				output[key] = redactedValue
+			} else {
+				output[key] = redactVariables(item)
+			}
+		}
+		return output
+	case []any:
+		output := make([]any, len(typed))
+		for i, item := range typed {
+			output[i] = redactVariables(item)
+		}
+		return output
+	default:
+		return value
+	}
+}
+
+func isRedactedKey(key string) bool {
+	key = strings.ToLower(key)
+	if key == "value" {
+		return true
+	}
+	for _, fragment := range redactedFragments {
+		if strings.Contains(key, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// graphQLResponseErrors collects the top level errors and the `errors` of each mutation payload
+func graphQLResponseErrors(body []byte) []string {
+	type graphQLError struct {
+		Message string `json:"message"`
+	}
+	var response struct {
+		Errors []graphQLError             `json:"errors"`
+		Data   map[string]json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil
+	}
+
+	var messages []string
+	for _, graphQLErr := range response.Errors {
+		messages = append(messages, graphQLErr.Message)
+	}
+	for _, payload := range response.Data {
+		var payloadErrors struct {
+			Errors []graphQLError `json:"errors"`
+		}
+		if err := json.Unmarshal(payload, &payloadErrors); err != nil {
+			continue
+		}
+		for _, graphQLErr := range payloadErrors.Errors {
+			messages = append(messages, graphQLErr.Message)
+		}
+	}
+	return messages
+}
@@ -0,0 +1,126 @@
+package opslevel
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestGraphQLLogTransportScopesEntriesToTheRequest(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER_OPSLEVEL_GRAPHQL", "TRACE")
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	ctx = tflog.SetField(ctx, "tf_resource_type", "opslevel_team")
+	ctx = tflog.SetField(ctx, "tf_rpc", "ReadResource")
+	ctx = tflog.SetField(ctx, "tf_req_id", "3f0c")
+
+	transport := newGraphQLLogTransport(ctx, roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"data":{}}`))}, nil
+	}))
+	req, err := http.NewRequest(http.MethodPost, "https://api.opslevel.com/graphql", strings.NewReader(`{"query":"query TeamGet{account{team{id}}}"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 log entry, got %d: %v", len(entries), entries)
+	}
+	expected := map[string]any{
+		"@module":          "provider." + graphQLLogSubsystem,
+		"operation":        "TeamGet",
+		"tf_resource_type": "opslevel_team",
+		"tf_rpc":           "ReadResource",
+		"tf_req_id":        "3f0c",
+	}
+	for key, value := range expected {
+		if entries[0][key] != value {
+			t.Errorf("expected %s to be '%v', got '%v'", key, value, entries[0][key])
+		}
+	}
+}
+
+func TestGraphQLLogTransportCancelsRequestsWithItsContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	transport := newGraphQLLogTransport(ctx, roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		cancel()
+		<-req.Context().Done()
+		return nil, req.Context().Err()
+	}))
+
+	req, err := http.NewRequest(http.MethodPost, "https://app.opslevel.com/graphql", strings.NewReader(`{"query":"{account{id}}"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := transport.RoundTrip(req); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the request to be cancelled with the transport context but got: %v", err)
+	}
+}
+
+func TestGraphQLRequestFields(t *testing.T) {
+	body := []byte(`{
+		"query": "mutation SecretCreate($alias:String!$input:SecretInput!){secretsVaultsSecretCreate(alias: $alias, input: $input){secret{id}}}",
+		"variables": {
+			"alias": "db-password",
+			"input": {"owner": {"alias": "platform"}, "value": "hunter2"},
+			"tags": [{"key": "env", "value": "prod"}],
+			"apiToken": "XXX"
+		}
+	}`)
+
+	fields := graphQLRequestFields(body)
+	if fields["operation"] != "SecretCreate" {
+		t.Errorf("expected operation 'SecretCreate', got '%v'", fields["operation"])
+	}
+
+	expected := map[string]any{
+		"alias":    "db-password",
+		"input":    map[string]any{"owner": map[string]any{"alias": "platform"}, "value": redactedValue},
+		"tags":     []any{map[string]any{"key": "env", "value": redactedValue}},
+		"apiToken": redactedValue,
+	}
+	if !reflect.DeepEqual(fields["variables"], expected) {
+		t.Errorf("expected variables %v, got %v", expected, fields["variables"])
+	}
+}
+
+func TestGraphQLResponseErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		body     string
+		expected []string
+	}{
+		{name: "no errors", body: `{"data": {"account": {"id": "1"}}}`, expected: nil},
+		{name: "top level errors", body: `{"errors": [{"message": "Field 'foo' doesn't exist"}]}`, expected: []string{"Field 'foo' doesn't exist"}},
+		{name: "payload errors", body: `{"data": {"serviceUpdate": {"service": null, "errors": [{"message": "Name can't be blank", "path": ["name"]}]}}}`, expected: []string{"Name can't be blank"}},
+		{name: "not json", body: `<html>Bad Gateway</html>`, expected: nil},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if messages := graphQLResponseErrors([]byte(testCase.body)); !reflect.DeepEqual(messages, testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, messages)
+			}
+		})
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/opslevel/opslevel-go/v2026"
 )
 
@@ -18,16 +21,20 @@ func read[T any](ctx context.Context, d *diag.Diagnostics, state TerraformSource
 	return data
 }
 
-func GetTeamID(d *diag.Diagnostics, client *opslevel.Client, identifier string) *opslevel.Nullable[opslevel.ID] {
-	if opslevel.IsID(identifier) {
-		return opslevel.RefOf(*opslevel.NewID(identifier))
-	}
-	team, err := client.GetTeamWithAlias(identifier)
+// writeOnlyStringValue reads a write-only attribute, Terraform only sends these in the config and never stores them in the plan or state
+func writeOnlyStringValue(ctx context.Context, d *diag.Diagnostics, config tfsdk.Config, attribute string) types.String {
+	var value types.String
+	d.Append(config.GetAttribute(ctx, path.Root(attribute), &value)...)
+	return value
+}
+
+func GetTeamID(d *diag.Diagnostics, cache *lookupCache, client *opslevel.Client, identifier string) *opslevel.Nullable[opslevel.ID] {
+	teamId, err := cache.resolveTeamID(client, identifier)
 	if err != nil {
 		d.AddError("opslevel error", fmt.Sprintf("failed to find team with alias '%s': %s", identifier, err))
 		return opslevel.RefOf(*opslevel.NewID())
 	}
-	return opslevel.RefOf(team.Id)
+	return opslevel.RefOf(teamId)
 }
 
 // Because the opslevel.RefOf changed to be a Nullable[T] we need a helper in here for backwards compatibility for things needed plain old *T
@@ -0,0 +1,82 @@
+package opslevel
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// transportSettings are the provider settings that change how requests reach the OpsLevel API,
+// mostly needed by self-hosted installs behind a TLS inspecting proxy
+type transportSettings struct {
+	caCertFile         string
+	insecureSkipVerify bool
+	proxyUrl           string
+	extraHeaders       map[string]string
+}
+
+// newBaseTransport builds the transport every other transport of the provider wraps
+func newBaseTransport(settings transportSettings) (http.RoundTripper, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if settings.caCertFile != "" || settings.insecureSkipVerify {
+		tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+		if settings.caCertFile != "" {
+			pool, err := loadCertPool(settings.caCertFile)
+			if err != nil {
+				return nil, err
+			}
+			tlsConfig.RootCAs = pool
+		}
+		// only ever true when explicitly requested with insecure_skip_verify
+		tlsConfig.InsecureSkipVerify = settings.insecureSkipVerify
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	if settings.proxyUrl != "" {
+		proxyUrl, err := url.Parse(settings.proxyUrl)
+		if err != nil || proxyUrl.Scheme == "" || proxyUrl.Host == "" {
+			return nil, fmt.Errorf("'%s' is not a valid proxy url", settings.proxyUrl)
+		}
+		transport.Proxy = http.ProxyURL(proxyUrl)
+	}
+
+	if len(settings.extraHeaders) == 0 {
+		return transport, nil
+	}
+	return &headerTransport{base: transport, headers: settings.extraHeaders}, nil
+}
+
+// loadCertPool adds the certificates of a PEM bundle to the system trust store
+func loadCertPool(caCertFile string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(expandHome(caCertFile))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read CA certificate file: %w", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no PEM encoded certificates found in '%s'", caCertFile)
+	}
+	return pool, nil
+}
+
+// headerTransport adds a fixed set of headers to every request
+type headerTransport struct {
+	base    http.RoundTripper
+	headers map[string]string
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	headerReq := req.Clone(req.Context())
+	for key, value := range t.headers {
+		headerReq.Header.Set(key, value)
+	}
+	return t.base.RoundTrip(headerReq)
+}
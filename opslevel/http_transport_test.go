@@ -0,0 +1,84 @@
+package opslevel
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBaseTransportCaCertFile(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+	certificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caCertFile, certificate, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	untrusted, err := newBaseTransport(transportSettings{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := (&http.Client{Transport: untrusted}).Get(server.URL); err == nil {
+		t.Errorf("expected the self signed certificate to be rejected without ca_cert_file")
+	}
+
+	trusted, err := newBaseTransport(transportSettings{caCertFile: caCertFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	response, err := (&http.Client{Transport: trusted}).Get(server.URL)
+	if err != nil {
+		t.Fatalf("expected the certificate in ca_cert_file to be trusted, got error: %s", err)
+	}
+	response.Body.Close()
+}
+
+func TestBaseTransportErrors(t *testing.T) {
+	emptyFile := filepath.Join(t.TempDir(), "empty.pem")
+	if err := os.WriteFile(emptyFile, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name     string
+		settings transportSettings
+	}{
+		{name: "missing ca_cert_file", settings: transportSettings{caCertFile: filepath.Join(t.TempDir(), "missing.pem")}},
+		{name: "ca_cert_file without certificates", settings: transportSettings{caCertFile: emptyFile}},
+		{name: "proxy_url without a scheme", settings: transportSettings{proxyUrl: "proxy.example.com"}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if _, err := newBaseTransport(testCase.settings); err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
+func TestBaseTransportExtraHeaders(t *testing.T) {
+	var received http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header
+	}))
+	defer server.Close()
+
+	transport, err := newBaseTransport(transportSettings{extraHeaders: map[string]string{"X-Tenant": "acme"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	response, err := (&http.Client{Transport: transport}).Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+
+	if received.Get("X-Tenant") != "acme" {
+		t.Errorf("expected the X-Tenant header to be sent, got %v", received)
+	}
+}
@@ -1,13 +1,33 @@
 package opslevel
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/opslevel/opslevel-go/v2026"
 )
 
+// importStateWithAlias imports an aliasable resource by its id or any of its aliases, always storing the canonical id in state
+func importStateWithAlias(ctx context.Context, client *opslevel.Client, ownerType opslevel.AliasOwnerTypeEnum, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != "" && !opslevel.IsID(req.ID) {
+		aliasable, err := client.GetAliasableResource(ownerType, req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to find %s with alias '%s', got error: %s", ownerType, req.ID, err))
+			return
+		}
+		if aliasable == nil || aliasable.ResourceId() == "" {
+			resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to find %s with alias '%s'", ownerType, req.ID))
+			return
+		}
+		req.ID = string(aliasable.ResourceId())
+	}
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
 func extractContactFromContacts(contactId opslevel.ID, contacts []opslevel.Contact) *opslevel.Contact {
 	for _, readContact := range contacts {
 		if contactId == readContact.Id {
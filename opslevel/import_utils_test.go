@@ -0,0 +1,70 @@
+package opslevel
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/opslevel/opslevel-go/v2026"
+	"github.com/opslevel/terraform-provider-opslevel/internal/fakeopslevel"
+)
+
+func TestImportStateWithAlias(t *testing.T) {
+	ctx := context.Background()
+	api := fakeopslevel.NewServer()
+	defer api.Close()
+	client := opslevel.NewGQLClient(opslevel.SetAPIToken("fake-token"), opslevel.SetURL(api.URL()), opslevel.SetMaxRetries(0))
+	teamId := api.Seed("team", map[string]any{"name": "Platform", "aliases": []any{"platform", "platform_team"}})
+
+	testCases := []struct {
+		name          string
+		importId      string
+		expectedId    string
+		expectedError string
+	}{
+		{name: "id", importId: teamId, expectedId: teamId},
+		{name: "alias", importId: "platform", expectedId: teamId},
+		{name: "other alias", importId: "platform_team", expectedId: teamId},
+		{name: "unknown alias", importId: "missing", expectedError: "Unable to find team with alias 'missing'"},
+	}
+
+	stateSchema := schema.Schema{Attributes: map[string]schema.Attribute{"id": schema.StringAttribute{Computed: true}}}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: stateSchema,
+					Raw:    tftypes.NewValue(stateSchema.Type().TerraformType(ctx), nil),
+				},
+				Identity: &tfsdk.ResourceIdentity{
+					Schema: idIdentitySchema,
+					Raw:    tftypes.NewValue(idIdentitySchema.Type().TerraformType(ctx), nil),
+				},
+			}
+
+			importStateWithAlias(ctx, client, opslevel.AliasOwnerTypeEnumTeam, resource.ImportStateRequest{ID: tc.importId}, resp)
+			if tc.expectedError != "" {
+				if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), tc.expectedError) {
+					t.Fatalf("expected an error containing '%s', got %v", tc.expectedError, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			state := read[idIdentityModel](ctx, &resp.Diagnostics, resp.State)
+			if state.Id.ValueString() != tc.expectedId {
+				t.Errorf("expected id '%s' in state, got '%s'", tc.expectedId, state.Id.ValueString())
+			}
+			identity := read[idIdentityModel](ctx, &resp.Diagnostics, resp.Identity)
+			if identity.Id != types.StringValue(tc.expectedId) {
+				t.Errorf("expected id '%s' in identity, got '%s'", tc.expectedId, identity.Id.ValueString())
+			}
+		})
+	}
+}
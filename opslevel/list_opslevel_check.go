@@ -0,0 +1,113 @@
+package opslevel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+// newCheckListResource lists the checks of a single type, since each check resource only manages one kind of check
+func newCheckListResource(checkResource resource.Resource, description string, checkType opslevel.CheckType) list.ListResource {
+	return &listResource[opslevel.Check]{
+		resource:    checkResource,
+		description: fmt.Sprintf("Lists every %s check in the account.", description),
+		listFunc: func(ctx context.Context, client *opslevel.Client, config tfsdk.Config, d *diag.Diagnostics) []opslevel.Check {
+			checks, err := client.ListChecks(nil)
+			if err != nil {
+				d.AddError("opslevel client error", fmt.Sprintf("Unable to list checks, got error: %s", err))
+				return nil
+			}
+			var output []opslevel.Check
+			for _, check := range checks.Nodes {
+				if check.Type == checkType {
+					output = append(output, check)
+				}
+			}
+			return output
+		},
+		describe: func(check opslevel.Check) (opslevel.ID, string) {
+			return check.Id, check.Name
+		},
+	}
+}
+
+func NewCheckAlertSourceUsageListResource() list.ListResource {
+	return newCheckListResource(NewCheckAlertSourceUsageResource(), "alert source usage", opslevel.CheckTypeAlertSourceUsage)
+}
+
+func NewCheckCodeIssueListResource() list.ListResource {
+	return newCheckListResource(NewCheckCodeIssueResource(), "code issue", opslevel.CheckTypeCodeIssue)
+}
+
+func NewCheckCustomEventListResource() list.ListResource {
+	return newCheckListResource(NewCheckCustomEventResource(), "custom event", opslevel.CheckTypeGeneric)
+}
+
+func NewCheckGitBranchProtectionListResource() list.ListResource {
+	return newCheckListResource(NewCheckGitBranchProtectionResource(), "git branch protection", opslevel.CheckTypeGitBranchProtection)
+}
+
+func NewCheckHasDocumentationListResource() list.ListResource {
+	return newCheckListResource(NewCheckHasDocumentationResource(), "has documentation", opslevel.CheckTypeHasDocumentation)
+}
+
+func NewCheckHasRecentDeployListResource() list.ListResource {
+	return newCheckListResource(NewCheckHasRecentDeployResource(), "has recent deploy", opslevel.CheckTypeHasRecentDeploy)
+}
+
+func NewCheckManualListResource() list.ListResource {
+	return newCheckListResource(NewCheckManualResource(), "manual", opslevel.CheckTypeManual)
+}
+
+func NewCheckPackageVersionListResource() list.ListResource {
+	return newCheckListResource(NewCheckPackageVersionResource(), "package version", opslevel.CheckTypePackageVersion)
+}
+
+func NewCheckRelationshipListResource() list.ListResource {
+	return newCheckListResource(NewCheckRelationshipResource(), "relationship", opslevel.CheckTypeRelationship)
+}
+
+func NewCheckRepositoryFileListResource() list.ListResource {
+	return newCheckListResource(NewCheckRepositoryFileResource(), "repository file", opslevel.CheckTypeRepoFile)
+}
+
+func NewCheckRepositoryGrepListResource() list.ListResource {
+	return newCheckListResource(NewCheckRepositoryGrepResource(), "repository grep", opslevel.CheckTypeRepoGrep)
+}
+
+func NewCheckRepositoryIntegratedListResource() list.ListResource {
+	return newCheckListResource(NewCheckRepositoryIntegratedResource(), "repository integrated", opslevel.CheckTypeHasRepository)
+}
+
+func NewCheckRepositorySearchListResource() list.ListResource {
+	return newCheckListResource(NewCheckRepositorySearchResource(), "repository search", opslevel.CheckTypeRepoSearch)
+}
+
+func NewCheckServiceConfigurationListResource() list.ListResource {
+	return newCheckListResource(NewCheckServiceConfigurationResource(), "service configuration", opslevel.CheckTypeHasServiceConfig)
+}
+
+func NewCheckServiceDependencyListResource() list.ListResource {
+	return newCheckListResource(NewCheckServiceDependencyResource(), "service dependency", opslevel.CheckTypeServiceDependency)
+}
+
+func NewCheckServiceOwnershipListResource() list.ListResource {
+	return newCheckListResource(NewCheckServiceOwnershipResource(), "service ownership", opslevel.CheckTypeHasOwner)
+}
+
+func NewCheckServicePropertyListResource() list.ListResource {
+	return newCheckListResource(NewCheckServicePropertyResource(), "service property", opslevel.CheckTypeServiceProperty)
+}
+
+func NewCheckTagDefinedListResource() list.ListResource {
+	return newCheckListResource(NewCheckTagDefinedResource(), "tag defined", opslevel.CheckTypeTagDefined)
+}
+
+func NewCheckToolUsageListResource() list.ListResource {
+	return newCheckListResource(NewCheckToolUsageResource(), "tool usage", opslevel.CheckTypeToolUsage)
+}
@@ -0,0 +1,29 @@
+package opslevel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+func NewDomainListResource() list.ListResource {
+	return &listResource[opslevel.Domain]{
+		resource:    NewDomainResource(),
+		description: "Lists every domain in the account.",
+		listFunc: func(ctx context.Context, client *opslevel.Client, config tfsdk.Config, d *diag.Diagnostics) []opslevel.Domain {
+			domains, err := client.ListDomains(nil)
+			if err != nil {
+				d.AddError("opslevel client error", fmt.Sprintf("Unable to list domains, got error: %s", err))
+				return nil
+			}
+			return domains.Nodes
+		},
+		describe: func(domain opslevel.Domain) (opslevel.ID, string) {
+			return domain.Id, domain.Name
+		},
+	}
+}
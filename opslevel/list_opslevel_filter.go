@@ -0,0 +1,29 @@
+package opslevel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+func NewFilterListResource() list.ListResource {
+	return &listResource[opslevel.Filter]{
+		resource:    NewFilterResource(),
+		description: "Lists every filter in the account.",
+		listFunc: func(ctx context.Context, client *opslevel.Client, config tfsdk.Config, d *diag.Diagnostics) []opslevel.Filter {
+			filters, err := client.ListFilters(nil)
+			if err != nil {
+				d.AddError("opslevel client error", fmt.Sprintf("Unable to list filters, got error: %s", err))
+				return nil
+			}
+			return filters.Nodes
+		},
+		describe: func(filter opslevel.Filter) (opslevel.ID, string) {
+			return filter.Id, filter.Name
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package opslevel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+func NewInfrastructureListResource() list.ListResource {
+	return &listResource[opslevel.InfrastructureResource]{
+		resource:    NewInfrastructureResource(),
+		description: "Lists every infrastructure resource in the account.",
+		listFunc: func(ctx context.Context, client *opslevel.Client, config tfsdk.Config, d *diag.Diagnostics) []opslevel.InfrastructureResource {
+			infrastructure, err := client.ListInfrastructure(nil)
+			if err != nil {
+				d.AddError("opslevel client error", fmt.Sprintf("Unable to list infrastructure resources, got error: %s", err))
+				return nil
+			}
+			return infrastructure.Nodes
+		},
+		describe: func(infrastructure opslevel.InfrastructureResource) (opslevel.ID, string) {
+			return infrastructure.Id, infrastructure.Name
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package opslevel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+func NewPropertyDefinitionListResource() list.ListResource {
+	return &listResource[opslevel.PropertyDefinition]{
+		resource:    NewPropertyDefinitionResource(),
+		description: "Lists every property definition in the account.",
+		listFunc: func(ctx context.Context, client *opslevel.Client, config tfsdk.Config, d *diag.Diagnostics) []opslevel.PropertyDefinition {
+			propertyDefinitions, err := client.ListPropertyDefinitions(nil)
+			if err != nil {
+				d.AddError("opslevel client error", fmt.Sprintf("Unable to list property definitions, got error: %s", err))
+				return nil
+			}
+			return propertyDefinitions.Nodes
+		},
+		describe: func(propertyDefinition opslevel.PropertyDefinition) (opslevel.ID, string) {
+			return propertyDefinition.Id, propertyDefinition.Name
+		},
+	}
+}
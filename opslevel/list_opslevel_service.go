@@ -0,0 +1,63 @@
+package opslevel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+// serviceListConfigModel describes the list block configuration of the opslevel_service list resource
+type serviceListConfigModel struct {
+	Filter *filterBlockModel `tfsdk:"filter"`
+}
+
+func NewServiceListResource() list.ListResource {
+	validFieldNames := []string{"component_type", "filter", "framework", "language", "lifecycle", "owner", "product", "tag", "tier"}
+	return &listResource[opslevel.Service]{
+		resource:    NewServiceResource(),
+		description: "Lists services, optionally narrowed by the same filters as the `opslevel_services` data source.",
+		configAttrs: map[string]listschema.Attribute{
+			"filter": listschema.SingleNestedAttribute{
+				Description: fmt.Sprintf(
+					"Used to filter services by one of '%s'",
+					strings.Join(validFieldNames, "`, `"),
+				),
+				Optional: true,
+				Attributes: map[string]listschema.Attribute{
+					"field": listschema.StringAttribute{
+						Description: fmt.Sprintf(
+							"The field of the target resource to filter upon. One of `%s`",
+							strings.Join(validFieldNames, "`, `"),
+						),
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(validFieldNames...),
+						},
+					},
+					"value": listschema.StringAttribute{
+						Description: "The field value of the target resource to match.",
+						Required:    true,
+					},
+				},
+			},
+		},
+		listFunc: func(ctx context.Context, client *opslevel.Client, config tfsdk.Config, d *diag.Diagnostics) []opslevel.Service {
+			configModel := read[serviceListConfigModel](ctx, d, config)
+			if d.HasError() {
+				return nil
+			}
+			return listServicesWithFilter(client, configModel.Filter, d)
+		},
+		describe: func(service opslevel.Service) (opslevel.ID, string) {
+			return service.Id, service.Name
+		},
+	}
+}
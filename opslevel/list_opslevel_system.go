@@ -0,0 +1,29 @@
+package opslevel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+func NewSystemListResource() list.ListResource {
+	return &listResource[opslevel.System]{
+		resource:    NewSystemResource(),
+		description: "Lists every system in the account.",
+		listFunc: func(ctx context.Context, client *opslevel.Client, config tfsdk.Config, d *diag.Diagnostics) []opslevel.System {
+			systems, err := client.ListSystems(nil)
+			if err != nil {
+				d.AddError("opslevel client error", fmt.Sprintf("Unable to list systems, got error: %s", err))
+				return nil
+			}
+			return systems.Nodes
+		},
+		describe: func(system opslevel.System) (opslevel.ID, string) {
+			return system.Id, system.Name
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package opslevel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+func NewTeamListResource() list.ListResource {
+	return &listResource[opslevel.Team]{
+		resource:    NewTeamResource(),
+		description: "Lists every team in the account.",
+		listFunc: func(ctx context.Context, client *opslevel.Client, config tfsdk.Config, d *diag.Diagnostics) []opslevel.Team {
+			teams, err := client.ListTeams(nil)
+			if err != nil {
+				d.AddError("opslevel client error", fmt.Sprintf("Unable to list teams, got error: %s", err))
+				return nil
+			}
+			return teams.Nodes
+		},
+		describe: func(team opslevel.Team) (opslevel.ID, string) {
+			return team.Id, team.Name
+		},
+	}
+}
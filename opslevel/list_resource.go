@@ -0,0 +1,94 @@
+package opslevel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+// listResource lists every instance of a managed resource so `terraform query` can discover and import them.
+// Each result is read through the managed resource's own Read, exactly as if it had just been imported by id,
+// so the generated configuration always matches what the resource itself would store.
+type listResource[T any] struct {
+	CommonResourceClient
+
+	// resource is the managed resource being listed, used for its type name and to read each result
+	resource    resource.Resource
+	description string
+	configAttrs map[string]listschema.Attribute
+	// listFunc returns every object matching the list block configuration
+	listFunc func(ctx context.Context, client *opslevel.Client, config tfsdk.Config, d *diag.Diagnostics) []T
+	// describe returns the id and display name of a listed object
+	describe func(T) (opslevel.ID, string)
+}
+
+var _ list.ListResourceWithConfigure = &listResource[opslevel.Service]{}
+
+func (r *listResource[T]) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	r.resource.Metadata(ctx, req, resp)
+}
+
+// Configure sets up the OpsLevel client for both the list resource and the managed resource it reads through
+func (r *listResource[T]) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.CommonResourceClient.Configure(ctx, req, resp)
+	if configurable, ok := r.resource.(resource.ResourceWithConfigure); ok {
+		configurable.Configure(ctx, req, resp)
+	}
+}
+
+func (r *listResource[T]) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: r.description,
+		Attributes:          r.configAttrs,
+	}
+}
+
+func (r *listResource[T]) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+	objects := r.listFunc(ctx, r.client, req.Config, &diags)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i, object := range objects {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			id, displayName := r.describe(object)
+			result := req.NewListResult(ctx)
+			result.DisplayName = displayName
+			result.Diagnostics.Append(result.Identity.Set(ctx, idIdentityModel{Id: types.StringValue(string(id))})...)
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				r.readResult(ctx, req, &result, id)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// readResult fills in the resource state of a list result using the managed resource's Read
+func (r *listResource[T]) readResult(ctx context.Context, req list.ListRequest, result *list.ListResult, id opslevel.ID) {
+	state := tfsdk.State{Schema: req.ResourceSchema, Raw: result.Resource.Raw}
+	result.Diagnostics.Append(state.SetAttribute(ctx, path.Root("id"), string(id))...)
+	if result.Diagnostics.HasError() {
+		return
+	}
+
+	readResp := resource.ReadResponse{State: state, Identity: result.Identity}
+	r.resource.Read(ctx, resource.ReadRequest{State: state, Identity: result.Identity}, &readResp)
+	result.Diagnostics.Append(readResp.Diagnostics...)
+	result.Resource.Raw = readResp.State.Raw
+}
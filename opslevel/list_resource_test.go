@@ -0,0 +1,56 @@
+package opslevel
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// TestAcceptanceTeamListResource lists a team created in the UI next to one managed by Terraform,
+// the way `terraform query` discovers objects to import
+func TestAcceptanceTeamListResource(t *testing.T) {
+	api := newFakeAPI(t)
+	seededId := api.Seed("team", map[string]any{"name": "Engineering", "responsibilities": "Builds the product"})
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig(api, `
+resource "opslevel_team" "test" {
+  name = "Platform"
+}
+`),
+			},
+			{
+				Query: true,
+				Config: providerConfig(api, `
+list "opslevel_team" "all" {
+  provider         = opslevel
+  include_resource = true
+}
+`),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("opslevel_team.all", 2),
+					querycheck.ExpectResourceDisplayName("opslevel_team.all", queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+						"id": knownvalue.StringExact(seededId),
+					}), knownvalue.StringExact("Engineering")),
+					querycheck.ExpectResourceKnownValues("opslevel_team.all", queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+						"id": knownvalue.StringExact(seededId),
+					}), []querycheck.KnownValueCheck{
+						{Path: tfjsonpath.New("name"), KnownValue: knownvalue.StringExact("Engineering")},
+						{Path: tfjsonpath.New("responsibilities"), KnownValue: knownvalue.StringExact("Builds the product")},
+					}),
+				},
+			},
+		},
+	})
+}
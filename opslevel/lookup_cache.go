@@ -0,0 +1,155 @@
+package opslevel
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+// lookupKind groups cached lookups by the type of object they return, so a mutation can invalidate all of them at once
+type lookupKind string
+
+const (
+	lookupKindCategory          lookupKind = "category"
+	lookupKindFilter            lookupKind = "filter"
+	lookupKindLevel             lookupKind = "level"
+	lookupKindLifecycle         lookupKind = "lifecycle"
+	lookupKindScorecardCategory lookupKind = "scorecard_category"
+	lookupKindTeam              lookupKind = "team"
+	lookupKindTier              lookupKind = "tier"
+)
+
+type lookupCacheEntry struct {
+	ready chan struct{}
+	value any
+	err   error
+}
+
+// lookupCache memoizes the read-only lookups (team ids, levels, categories, scorecard categories, lifecycles, tiers and referenced filters)
+// that many resources and data sources repeat during a single plan or apply.
+// It lives for as long as the configured provider and is shared through providerData.
+// A nil *lookupCache is valid and always calls through to the API.
+type lookupCache struct {
+	mu      sync.Mutex
+	entries map[lookupKind]map[string]*lookupCacheEntry
+}
+
+func newLookupCache() *lookupCache {
+	return &lookupCache{
+		entries: map[lookupKind]map[string]*lookupCacheEntry{},
+	}
+}
+
+// cachedLookup returns the cached value for kind and key, calling fetch at most once for concurrent callers.
+// Errors are never cached so a failed lookup is retried by the next caller.
+func cachedLookup[T any](c *lookupCache, kind lookupKind, key string, fetch func() (T, error)) (T, error) {
+	if c == nil {
+		return fetch()
+	}
+
+	c.mu.Lock()
+	if c.entries[kind] == nil {
+		c.entries[kind] = map[string]*lookupCacheEntry{}
+	}
+	if entry, ok := c.entries[kind][key]; ok {
+		c.mu.Unlock()
+		<-entry.ready
+		if entry.err != nil {
+			return fetch()
+		}
+		return entry.value.(T), nil
+	}
+	entry := &lookupCacheEntry{ready: make(chan struct{})}
+	c.entries[kind][key] = entry
+	c.mu.Unlock()
+
+	value, err := fetch()
+	entry.value, entry.err = value, err
+	close(entry.ready)
+
+	if err != nil {
+		c.mu.Lock()
+		if c.entries[kind][key] == entry {
+			delete(c.entries[kind], key)
+		}
+		c.mu.Unlock()
+	}
+	return value, err
+}
+
+// invalidate drops every cached lookup of the given kinds. Call it after any mutation of those objects.
+func (c *lookupCache) invalidate(kinds ...lookupKind) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, kind := range kinds {
+		delete(c.entries, kind)
+	}
+}
+
+// resolveTeamID returns the id of the team with the given id or alias
+func (c *lookupCache) resolveTeamID(client *opslevel.Client, identifier string) (opslevel.ID, error) {
+	if opslevel.IsID(identifier) {
+		return opslevel.ID(identifier), nil
+	}
+	return cachedLookup(c, lookupKindTeam, identifier, func() (opslevel.ID, error) {
+		team, err := client.GetTeamWithAlias(identifier)
+		if err != nil {
+			return "", err
+		}
+		if team == nil || team.Id == "" {
+			return "", fmt.Errorf("team with alias '%s' not found", identifier)
+		}
+		return team.Id, nil
+	})
+}
+
+func (c *lookupCache) listCategories(client *opslevel.Client) ([]opslevel.Category, error) {
+	return cachedLookup(c, lookupKindCategory, "", func() ([]opslevel.Category, error) {
+		categories, err := client.ListCategories(nil)
+		if err != nil {
+			return nil, err
+		}
+		return categories.Nodes, nil
+	})
+}
+
+// listScorecardCategories returns the categories of a scorecard, cached by the scorecard id
+func (c *lookupCache) listScorecardCategories(client *opslevel.Client, scorecard *opslevel.Scorecard) ([]opslevel.Category, error) {
+	return cachedLookup(c, lookupKindScorecardCategory, string(scorecard.Id), func() ([]opslevel.Category, error) {
+		categories, err := scorecard.ListCategories(client, nil)
+		if err != nil {
+			return nil, err
+		}
+		if categories == nil {
+			return nil, fmt.Errorf("no categories returned for scorecard '%s'", scorecard.Id)
+		}
+		return categories.Nodes, nil
+	})
+}
+
+func (c *lookupCache) listLevels(client *opslevel.Client) ([]opslevel.Level, error) {
+	return cachedLookup(c, lookupKindLevel, "", func() ([]opslevel.Level, error) {
+		levels, err := client.ListLevels(nil)
+		if err != nil {
+			return nil, err
+		}
+		return levels.Nodes, nil
+	})
+}
+
+func (c *lookupCache) listLifecycles(client *opslevel.Client) ([]opslevel.Lifecycle, error) {
+	return cachedLookup(c, lookupKindLifecycle, "", func() ([]opslevel.Lifecycle, error) {
+		return client.ListLifecycles()
+	})
+}
+
+func (c *lookupCache) listTiers(client *opslevel.Client) ([]opslevel.Tier, error) {
+	return cachedLookup(c, lookupKindTier, "", func() ([]opslevel.Tier, error) {
+		return client.ListTiers()
+	})
+}
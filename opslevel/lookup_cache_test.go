@@ -0,0 +1,150 @@
+package opslevel
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCachedLookupMemoizes(t *testing.T) {
+	cache := newLookupCache()
+	calls := 0
+	fetch := func() (string, error) {
+		calls++
+		return "value", nil
+	}
+
+	for range 3 {
+		value, err := cachedLookup(cache, lookupKindTier, "tier_1", fetch)
+		if err != nil || value != "value" {
+			t.Fatalf("expected 'value', got '%s' and error: %v", value, err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 fetch, got %d", calls)
+	}
+
+	if _, err := cachedLookup(cache, lookupKindTier, "tier_2", fetch); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cachedLookup(cache, lookupKindLevel, "tier_1", fetch); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 3 {
+		t.Errorf("expected another key and kind to be fetched separately, got %d fetches", calls)
+	}
+}
+
+func TestCachedLookupDoesNotCacheErrors(t *testing.T) {
+	cache := newLookupCache()
+	calls := 0
+	fetch := func() (string, error) {
+		calls++
+		if calls == 1 {
+			return "", errors.New("boom")
+		}
+		return "value", nil
+	}
+
+	if _, err := cachedLookup(cache, lookupKindTeam, "platform", fetch); err == nil {
+		t.Fatal("expected the first lookup to fail")
+	}
+	value, err := cachedLookup(cache, lookupKindTeam, "platform", fetch)
+	if err != nil || value != "value" {
+		t.Fatalf("expected the failed lookup to be retried, got '%s' and error: %v", value, err)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 fetches, got %d", calls)
+	}
+}
+
+func TestLookupCacheInvalidate(t *testing.T) {
+	cache := newLookupCache()
+	calls := map[lookupKind]int{}
+	lookup := func(kind lookupKind) {
+		_, err := cachedLookup(cache, kind, "key", func() (string, error) {
+			calls[kind]++
+			return "value", nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	lookup(lookupKindCategory)
+	lookup(lookupKindScorecardCategory)
+	lookup(lookupKindTeam)
+	cache.invalidate(lookupKindCategory, lookupKindScorecardCategory)
+	lookup(lookupKindCategory)
+	lookup(lookupKindScorecardCategory)
+	lookup(lookupKindTeam)
+
+	expected := map[lookupKind]int{lookupKindCategory: 2, lookupKindScorecardCategory: 2, lookupKindTeam: 1}
+	for kind, count := range expected {
+		if calls[kind] != count {
+			t.Errorf("expected %d fetches of %s, got %d", count, kind, calls[kind])
+		}
+	}
+}
+
+func TestNilLookupCache(t *testing.T) {
+	var cache *lookupCache
+	calls := 0
+	fetch := func() (string, error) {
+		calls++
+		return "value", nil
+	}
+
+	cachedLookup(cache, lookupKindTier, "tier_1", fetch)
+	cachedLookup(cache, lookupKindTier, "tier_1", fetch)
+	cache.invalidate(lookupKindTier)
+	if calls != 2 {
+		t.Errorf("expected a nil cache to call through every time, got %d fetches", calls)
+	}
+}
+
+func TestCachedLookupConcurrentCallersShareOneFetch(t *testing.T) {
+	cache := newLookupCache()
+	var calls atomic.Int32
+	release := make(chan struct{})
+	fetch := func() (string, error) {
+		calls.Add(1)
+		<-release
+		return "value", nil
+	}
+
+	var wg sync.WaitGroup
+	values := make([]string, 10)
+	for i := range values {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			values[i], _ = cachedLookup(cache, lookupKindFilter, "filter", fetch)
+		}()
+	}
+	close(release)
+	wg.Wait()
+
+	if calls.Load() != 1 {
+		t.Errorf("expected 1 fetch, got %d", calls.Load())
+	}
+	for i, value := range values {
+		if value != "value" {
+			t.Errorf("expected caller %d to get 'value', got '%s'", i, value)
+		}
+	}
+}
+
+func TestResolveTeamIDSkipsLookupForIds(t *testing.T) {
+	cache := newLookupCache()
+	id := "Z2lkOi8vb3BzbGV2ZWwvVGVhbS8xMjM="
+
+	teamId, err := cache.resolveTeamID(nil, id)
+	if err != nil || string(teamId) != id {
+		t.Fatalf("expected '%s', got '%s' and error: %v", id, teamId, err)
+	}
+	if len(cache.entries[lookupKindTeam]) != 0 {
+		t.Errorf("expected ids not to be cached, got %d entries", len(cache.entries[lookupKindTeam]))
+	}
+}
@@ -3,14 +3,24 @@ package opslevel
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"slices"
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/opslevel/opslevel-go/v2026"
@@ -21,14 +31,36 @@ const defaultApiTimeout = int64(30)
 // Ensure the implementation satisfies the provider.Provider interface.
 var _ provider.ProviderWithValidateConfig = &OpslevelProvider{}
 
+var _ provider.ProviderWithEphemeralResources = &OpslevelProvider{}
+
+var _ provider.ProviderWithListResources = &OpslevelProvider{}
+
+var _ provider.ProviderWithFunctions = &OpslevelProvider{}
+
 type OpslevelProvider struct {
 	version string
 }
 
 type OpslevelProviderModel struct {
-	ApiToken   types.String `tfsdk:"api_token"`
-	ApiUrl     types.String `tfsdk:"api_url"`
-	ApiTimeout types.Int64  `tfsdk:"api_timeout"`
+	ApiToken                  types.String `tfsdk:"api_token"`
+	ApiTokenFile              types.String `tfsdk:"api_token_file"`
+	ApiUrl                    types.String `tfsdk:"api_url"`
+	ApiTimeout                types.Int64  `tfsdk:"api_timeout"`
+	MaxRequestsPerMinute      types.Int64  `tfsdk:"max_requests_per_minute"`
+	MaxRetries                types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait              types.Int64  `tfsdk:"retry_max_wait"`
+	ReadOnly                  types.Bool   `tfsdk:"read_only"`
+	SkipCredentialsValidation types.Bool   `tfsdk:"skip_credentials_validation"`
+	ValidateReferences        types.Bool   `tfsdk:"validate_references"`
+	SharedCredentialsFile     types.String `tfsdk:"shared_credentials_file"`
+	Profile                   types.String `tfsdk:"profile"`
+	CaCertFile                types.String `tfsdk:"ca_cert_file"`
+	InsecureSkipVerify        types.Bool   `tfsdk:"insecure_skip_verify"`
+	ProxyUrl                  types.String `tfsdk:"proxy_url"`
+	ExtraHeaders              types.Map    `tfsdk:"extra_headers"`
+
+	DefaultTags *defaultTagsModel `tfsdk:"default_tags"`
+	IgnoreTags  *ignoreTagsModel  `tfsdk:"ignore_tags"`
 }
 
 func (p *OpslevelProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -44,6 +76,13 @@ func (p *OpslevelProvider) Schema(ctx context.Context, req provider.SchemaReques
 				Description: "The API authorization token. It can also be sourced from the OPSLEVEL_API_TOKEN environment variable.",
 				Sensitive:   true,
 			},
+			"api_token_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a file holding the API authorization token. It can also be sourced from the OPSLEVEL_API_TOKEN_FILE environment variable.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("api_token")),
+				},
+			},
 			"api_url": schema.StringAttribute{
 				Optional:    true,
 				Description: "The url of the OpsLevel API to. It can also be sourced from the OPSLEVEL_API_URL environment variable.",
@@ -54,6 +93,98 @@ func (p *OpslevelProvider) Schema(ctx context.Context, req provider.SchemaReques
 				Description: "Value (in seconds) to use for the timeout of API calls made.  It can also be sourced from the OPSLEVEL_API_TIMEOUT environment variable.",
 				Sensitive:   false,
 			},
+			"ca_cert_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a PEM encoded CA bundle trusted in addition to the system certificates, for self-hosted OpsLevel or a TLS inspecting proxy. It can also be sourced from the OPSLEVEL_CA_CERT_FILE environment variable.",
+			},
+			"extra_headers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Additional HTTP headers sent with every API request, for example to authenticate with a proxy. The `Authorization` header can't be replaced.",
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.NoneOfCaseInsensitive("Authorization")),
+				},
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When true, the TLS certificate of the OpsLevel API is not verified. Only meant for testing, prefer `ca_cert_file`.",
+			},
+			"max_requests_per_minute": schema.Int64Attribute{
+				Optional: true,
+				Description: fmt.Sprintf(
+					"The maximum number of API requests the provider sends per minute, shared across all resources and data sources. Defaults to %d. It can also be sourced from the OPSLEVEL_MAX_REQUESTS_PER_MINUTE environment variable.",
+					defaultMaxRequestsPerMinute,
+				),
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional: true,
+				Description: fmt.Sprintf(
+					"The maximum number of times a rate limited or temporarily unavailable API request is retried. Defaults to %d. It can also be sourced from the OPSLEVEL_MAX_RETRIES environment variable.",
+					defaultMaxRetries,
+				),
+				Validators: []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"profile": schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("The profile of the shared credentials file to read `api_token`, `api_url` and `api_timeout` from. Defaults to `%s`. When set here, the profile wins over OPSLEVEL_API_TOKEN and OPSLEVEL_API_URL. It can also be sourced from the OPSLEVEL_PROFILE environment variable.", defaultProfile),
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "The url of an HTTP proxy to send API requests through. Defaults to the HTTPS_PROXY and HTTP_PROXY environment variables. It can also be sourced from the OPSLEVEL_PROXY_URL environment variable.",
+			},
+			"read_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When true, every create, update and delete fails before anything is sent to OpsLevel, while reads and data sources keep working. Useful for drift detection plans run with a production token. It can also be sourced from the OPSLEVEL_READ_ONLY environment variable.",
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				Optional: true,
+				Description: fmt.Sprintf(
+					"The maximum time (in seconds) to wait between retries of an API request. Defaults to %d. It can also be sourced from the OPSLEVEL_RETRY_MAX_WAIT environment variable.",
+					defaultRetryMaxWait,
+				),
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"shared_credentials_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a shared credentials file holding one section per profile. Defaults to `~/.opslevel/credentials`. It can also be sourced from the OPSLEVEL_SHARED_CREDENTIALS_FILE environment variable.",
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When true, the provider doesn't check that the API token is valid while it is configured. Useful for offline runs or runs against a mock API. It can also be sourced from the OPSLEVEL_SKIP_CREDENTIALS_VALIDATION environment variable.",
+			},
+			"validate_references": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When true, `terraform plan` checks that the categories, levels, filters and owners of checks and the owners, lifecycles and tiers of services exist, at the cost of extra API requests. It can also be sourced from the OPSLEVEL_VALIDATE_REFERENCES environment variable.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"default_tags": schema.SingleNestedBlock{
+				Description: "Tags applied to every resource that manages a full set of tags, such as `opslevel_service`. Tags set on the resource win over a default tag with the same key. When a resource does not set `tags`, only the default tag keys are managed and its other tags are left as they are.",
+				Attributes: map[string]schema.Attribute{
+					"tags": schema.SetAttribute{
+						ElementType: types.StringType,
+						Description: "A list of `key:value` tags to apply to every taggable resource.",
+						Optional:    true,
+						Validators:  []validator.Set{TagFormatValidator()},
+					},
+				},
+			},
+			"ignore_tags": schema.SingleNestedBlock{
+				Description: "Tags managed outside of Terraform, for example by the AWS, Azure or Kubernetes integrations. Matching tags are left out of the state and never removed when tags are reconciled.",
+				Attributes: map[string]schema.Attribute{
+					"keys": schema.SetAttribute{
+						ElementType: types.StringType,
+						Description: "Tag keys to ignore.",
+						Optional:    true,
+					},
+					"key_prefixes": schema.SetAttribute{
+						ElementType: types.StringType,
+						Description: "Tag key prefixes to ignore.",
+						Optional:    true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -64,49 +195,165 @@ func (p *OpslevelProvider) ValidateConfig(ctx context.Context, req provider.Vali
 		return
 	}
 
-	if providerModel.ApiToken.IsNull() && os.Getenv("OPSLEVEL_API_TOKEN") == "" {
+	if !hasApiTokenSource(providerModel) {
 		resp.Diagnostics.AddError(
 			"Provider Config Error",
 			"An OPSLEVEL_API_TOKEN is needed to authenticate with the opslevel client. "+
-				"This can be set as an 'OPSLEVEL_API_TOKEN' environment variable or in the provider configuration block as 'api_token'.",
+				"This can be set as an 'OPSLEVEL_API_TOKEN' environment variable or in the provider configuration block as 'api_token', "+
+				"read from a file with 'api_token_file', or read from a profile of the shared credentials file.",
+		)
+	}
+}
+
+// hasApiTokenSource reports whether any of the places an API token can come from is configured
+func hasApiTokenSource(data OpslevelProviderModel) bool {
+	if !data.ApiToken.IsNull() || !data.ApiTokenFile.IsNull() || !data.Profile.IsNull() || !data.SharedCredentialsFile.IsNull() {
+		return true
+	}
+	for _, envVar := range []string{"OPSLEVEL_API_TOKEN", "OPSLEVEL_API_TOKEN_FILE", "OPSLEVEL_PROFILE", "OPSLEVEL_SHARED_CREDENTIALS_FILE"} {
+		if os.Getenv(envVar) != "" {
+			return true
+		}
+	}
+	if credentialsFile := defaultSharedCredentialsFile(); credentialsFile != "" {
+		if _, err := os.Stat(credentialsFile); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// configProfile reads the selected profile of the shared credentials file.
+// A missing default file is fine unless a profile or file was asked for, most setups only use api_token.
+func configProfile(ctx context.Context, data *OpslevelProviderModel, resp *provider.ConfigureResponse) *credentialsProfile {
+	profile := data.Profile.ValueString()
+	if profile == "" {
+		profile = os.Getenv("OPSLEVEL_PROFILE")
+	}
+	credentialsFile := data.SharedCredentialsFile.ValueString()
+	if credentialsFile == "" {
+		credentialsFile = os.Getenv("OPSLEVEL_SHARED_CREDENTIALS_FILE")
+	}
+	explicit := profile != "" || credentialsFile != ""
+
+	if profile == "" {
+		profile = defaultProfile
+	}
+	if credentialsFile == "" {
+		credentialsFile = defaultSharedCredentialsFile()
+	}
+
+	credentials, err := readCredentialsProfile(credentialsFile, profile)
+	if err == nil {
+		return credentials
+	}
+	if explicit {
+		resp.Diagnostics.AddError(
+			"Unable to read OpsLevel credentials file",
+			fmt.Sprintf("Unable to read profile '%s' from the shared credentials file, got error: %s", profile, err),
 		)
+	} else {
+		tflog.Debug(ctx, fmt.Sprintf("Not using the default shared credentials file: %s", err))
 	}
+	return nil
 }
 
-func configApiToken(data *OpslevelProviderModel, resp *provider.ConfigureResponse) {
+// profileInConfig reports whether the provider block itself selects the shared credentials file profile.
+// The profile then wins over OPSLEVEL_API_TOKEN and OPSLEVEL_API_URL, so a token and url from different accounts are never paired.
+func profileInConfig(data *OpslevelProviderModel, profile *credentialsProfile) bool {
+	return profile != nil && (!data.Profile.IsNull() || !data.SharedCredentialsFile.IsNull())
+}
+
+// configApiToken resolves the API token from, in order, `api_token`, `api_token_file`,
+// OPSLEVEL_API_TOKEN, OPSLEVEL_API_TOKEN_FILE and finally the shared credentials file profile,
+// which comes right after `api_token_file` when the provider block selects it.
+// It returns where the token came from, to name it in diagnostics.
+func configApiToken(data *OpslevelProviderModel, profile *credentialsProfile, resp *provider.ConfigureResponse) string {
 	if data.ApiToken.ValueString() != "" {
-		return
+		return "'api_token'"
+	}
+
+	if tokenFile := data.ApiTokenFile.ValueString(); tokenFile != "" {
+		configApiTokenFile(data, tokenFile, resp)
+		return fmt.Sprintf("'api_token_file' ('%s')", tokenFile)
+	}
+
+	if profileInConfig(data, profile) {
+		if profile.ApiToken == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("profile"),
+				"Missing OpsLevel API token in profile",
+				"The profile selected in the provider configuration has no 'api_token'. "+
+					"Add one to the shared credentials file, or set 'api_token' or 'api_token_file'. "+
+					"OPSLEVEL_API_TOKEN is not used, as it may belong to another account than the profile.",
+			)
+			return ""
+		}
+		data.ApiToken = types.StringValue(profile.ApiToken)
+		return "the shared credentials file"
 	}
 
 	if apiToken, ok := os.LookupEnv("OPSLEVEL_API_TOKEN"); ok {
 		data.ApiToken = types.StringValue(apiToken)
-		return
+		return "OPSLEVEL_API_TOKEN"
+	}
+
+	if tokenFile := os.Getenv("OPSLEVEL_API_TOKEN_FILE"); tokenFile != "" {
+		configApiTokenFile(data, tokenFile, resp)
+		return fmt.Sprintf("OPSLEVEL_API_TOKEN_FILE ('%s')", tokenFile)
+	}
+
+	if profile != nil && profile.ApiToken != "" {
+		data.ApiToken = types.StringValue(profile.ApiToken)
+		return "the shared credentials file"
 	}
 
 	resp.Diagnostics.AddError(
 		"Missing OPSLEVEL_API_TOKEN",
 		"An OPSLEVEL_API_TOKEN is needed to authenticate with the opslevel client. "+
-			"This can be set as an environment variable or in the provider configuration block as 'api_token'.",
+			"This can be set as an environment variable or in the provider configuration block as 'api_token', "+
+			"read from a file with 'api_token_file', or read from a profile of the shared credentials file.",
 	)
+	return ""
 }
 
-func configApiUrl(data *OpslevelProviderModel) {
+func configApiTokenFile(data *OpslevelProviderModel, tokenFile string, resp *provider.ConfigureResponse) {
+	apiToken, err := readTokenFile(tokenFile)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read OpsLevel API token file",
+			fmt.Sprintf("Unable to read the API token from '%s', got error: %s", tokenFile, err),
+		)
+		return
+	}
+	data.ApiToken = types.StringValue(apiToken)
+}
+
+// configApiUrl resolves the API url from `api_url`, OPSLEVEL_API_URL and then the shared credentials file profile.
+// When the provider block selects the profile, OPSLEVEL_API_URL is not used, the url must match the profile's token.
+func configApiUrl(data *OpslevelProviderModel, profile *credentialsProfile) {
 	if data.ApiUrl.IsNull() || data.ApiUrl.Equal(types.StringValue("")) {
-		if apiUrl, ok := os.LookupEnv("OPSLEVEL_API_URL"); ok {
+		if apiUrl, ok := os.LookupEnv("OPSLEVEL_API_URL"); ok && !profileInConfig(data, profile) {
 			data.ApiUrl = types.StringValue(apiUrl)
+		} else if profile != nil && profile.ApiUrl != "" {
+			data.ApiUrl = types.StringValue(profile.ApiUrl)
 		} else {
 			data.ApiUrl = types.StringValue("https://api.opslevel.com/")
 		}
 	}
 }
 
-func configApiTimeOut(data *OpslevelProviderModel, resp *provider.ConfigureResponse) {
+func configApiTimeOut(data *OpslevelProviderModel, profile *credentialsProfile, resp *provider.ConfigureResponse) {
 	if data.ApiTimeout.ValueInt64() > 0 {
 		return
 	}
 
 	apiTimeout, ok := os.LookupEnv("OPSLEVEL_API_TIMEOUT")
 	if !ok {
+		if profile != nil && profile.ApiTimeout > 0 {
+			data.ApiTimeout = types.Int64Value(profile.ApiTimeout)
+			return
+		}
 		data.ApiTimeout = types.Int64Value(defaultApiTimeout)
 		return
 	}
@@ -127,6 +374,135 @@ func configApiTimeOut(data *OpslevelProviderModel, resp *provider.ConfigureRespo
 	)
 }
 
+func configReadOnly(data *OpslevelProviderModel, resp *provider.ConfigureResponse) {
+	if !data.ReadOnly.IsNull() && !data.ReadOnly.IsUnknown() {
+		return
+	}
+
+	readOnly, ok := os.LookupEnv("OPSLEVEL_READ_ONLY")
+	if !ok || readOnly == "" {
+		data.ReadOnly = types.BoolValue(false)
+		return
+	}
+
+	if value, err := strconv.ParseBool(readOnly); err == nil {
+		data.ReadOnly = types.BoolValue(value)
+		return
+	}
+
+	// fail closed, a typo in the environment variable should never allow changes
+	data.ReadOnly = types.BoolValue(true)
+	resp.Diagnostics.AddWarning(
+		"Expected OPSLEVEL_READ_ONLY to be a bool",
+		fmt.Sprintf("OPSLEVEL_READ_ONLY was set to '%s'. The provider will run in read only mode.", readOnly),
+	)
+}
+
+func configSkipCredentialsValidation(data *OpslevelProviderModel, resp *provider.ConfigureResponse) {
+	if !data.SkipCredentialsValidation.IsNull() && !data.SkipCredentialsValidation.IsUnknown() {
+		return
+	}
+
+	skip, ok := os.LookupEnv("OPSLEVEL_SKIP_CREDENTIALS_VALIDATION")
+	if !ok || skip == "" {
+		data.SkipCredentialsValidation = types.BoolValue(false)
+		return
+	}
+
+	if value, err := strconv.ParseBool(skip); err == nil {
+		data.SkipCredentialsValidation = types.BoolValue(value)
+		return
+	}
+
+	data.SkipCredentialsValidation = types.BoolValue(false)
+	resp.Diagnostics.AddWarning(
+		"Expected OPSLEVEL_SKIP_CREDENTIALS_VALIDATION to be a bool",
+		fmt.Sprintf("OPSLEVEL_SKIP_CREDENTIALS_VALIDATION was set to '%s'. The credentials will be validated.", skip),
+	)
+}
+
+func configValidateReferences(data *OpslevelProviderModel, resp *provider.ConfigureResponse) {
+	if !data.ValidateReferences.IsNull() && !data.ValidateReferences.IsUnknown() {
+		return
+	}
+
+	validate, ok := os.LookupEnv("OPSLEVEL_VALIDATE_REFERENCES")
+	if !ok || validate == "" {
+		data.ValidateReferences = types.BoolValue(false)
+		return
+	}
+
+	if value, err := strconv.ParseBool(validate); err == nil {
+		data.ValidateReferences = types.BoolValue(value)
+		return
+	}
+
+	data.ValidateReferences = types.BoolValue(false)
+	resp.Diagnostics.AddWarning(
+		"Expected OPSLEVEL_VALIDATE_REFERENCES to be a bool",
+		fmt.Sprintf("OPSLEVEL_VALIDATE_REFERENCES was set to '%s'. References will not be validated during plan.", validate),
+	)
+}
+
+// configTransport reads the settings of the HTTP client used to reach the OpsLevel API
+func configTransport(ctx context.Context, data *OpslevelProviderModel, resp *provider.ConfigureResponse) transportSettings {
+	settings := transportSettings{
+		caCertFile:         data.CaCertFile.ValueString(),
+		insecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+		proxyUrl:           data.ProxyUrl.ValueString(),
+	}
+	if settings.caCertFile == "" {
+		settings.caCertFile = os.Getenv("OPSLEVEL_CA_CERT_FILE")
+	}
+	if settings.proxyUrl == "" {
+		settings.proxyUrl = os.Getenv("OPSLEVEL_PROXY_URL")
+	}
+	if !data.ExtraHeaders.IsNull() && !data.ExtraHeaders.IsUnknown() {
+		resp.Diagnostics.Append(data.ExtraHeaders.ElementsAs(ctx, &settings.extraHeaders, false)...)
+	}
+
+	if settings.insecureSkipVerify {
+		resp.Diagnostics.AddWarning(
+			"TLS certificate verification is disabled",
+			"'insecure_skip_verify' is set, the certificate of the OpsLevel API will not be verified. Use 'ca_cert_file' to trust a private certificate authority instead.",
+		)
+	}
+	return settings
+}
+
+// configInt64 sets an optional number attribute from its environment variable, or its default value if neither is set.
+// The schema validators never see environment variables, so the merged value is checked against minValue here.
+func configInt64(value *types.Int64, attribute string, envVar string, defaultValue int64, minValue int64, resp *provider.ConfigureResponse) {
+	source := fmt.Sprintf("'%s'", attribute)
+	if value.IsNull() || value.IsUnknown() {
+		envValue, ok := os.LookupEnv(envVar)
+		if !ok {
+			*value = types.Int64Value(defaultValue)
+			return
+		}
+
+		parsedValue, err := strconv.ParseInt(envValue, 10, 64)
+		if err != nil {
+			*value = types.Int64Value(defaultValue)
+			resp.Diagnostics.AddWarning(
+				fmt.Sprintf("Expected %s to be an int", envVar),
+				fmt.Sprintf("%s was set to '%s'. The default value of %d will be used.", envVar, envValue, defaultValue),
+			)
+			return
+		}
+		*value = types.Int64Value(parsedValue)
+		source = envVar
+	}
+
+	if value.ValueInt64() < minValue {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			fmt.Sprintf("Invalid %s", attribute),
+			fmt.Sprintf("%s was set to %d, it must be at least %d.", source, value.ValueInt64(), minValue),
+		)
+	}
+}
+
 func (p *OpslevelProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	tflog.Info(ctx, "Initializing opslevel client")
 
@@ -136,35 +512,107 @@ func (p *OpslevelProvider) Configure(ctx context.Context, req provider.Configure
 	}
 
 	// Configuration values are now available.
+	profile := configProfile(ctx, &data, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "Setting opslevel client API token...")
-	configApiToken(&data, resp)
+	tokenSource := configApiToken(&data, profile, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	tflog.Debug(ctx, "opslevel client API token is set")
 
 	tflog.Debug(ctx, "Setting opslevel client API endpoint URL...")
-	configApiUrl(&data)
+	configApiUrl(&data, profile)
 	tflog.Debug(ctx, "opslevel client API endpoint URL is set")
 
 	tflog.Debug(ctx, "Setting opslevel client API timeout...")
-	configApiTimeOut(&data, resp)
+	configApiTimeOut(&data, profile, resp)
 	tflog.Debug(ctx, "opslevel client API timeout is set")
 
+	tflog.Debug(ctx, "Setting opslevel client rate limit and retries...")
+	configInt64(&data.MaxRequestsPerMinute, "max_requests_per_minute", "OPSLEVEL_MAX_REQUESTS_PER_MINUTE", defaultMaxRequestsPerMinute, 1, resp)
+	configInt64(&data.MaxRetries, "max_retries", "OPSLEVEL_MAX_RETRIES", defaultMaxRetries, 0, resp)
+	configInt64(&data.RetryMaxWait, "retry_max_wait", "OPSLEVEL_RETRY_MAX_WAIT", defaultRetryMaxWait, 1, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "opslevel client rate limit and retries are set")
+
+	configReadOnly(&data, resp)
+	configSkipCredentialsValidation(&data, resp)
+	configValidateReferences(&data, resp)
+
+	baseTransport, err := newBaseTransport(configTransport(ctx, &data, resp))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to configure the OpsLevel HTTP client",
+			fmt.Sprintf("Unable to set up the connection to the OpsLevel API, got error: %s", err),
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// every resource and data source shares this transport, so the rate limit applies to the whole apply
+	var transport http.RoundTripper = newRateLimitedTransport(
+		baseTransport,
+		data.MaxRequestsPerMinute.ValueInt64(),
+		data.MaxRetries.ValueInt64(),
+		time.Second*time.Duration(data.RetryMaxWait.ValueInt64()),
+	)
+	if data.ReadOnly.ValueBool() {
+		tflog.Info(ctx, "OpsLevel provider is read only, GraphQL mutations will be refused")
+		transport = newReadOnlyTransport(transport)
+	}
+
 	opts := []opslevel.Option{
 		opslevel.SetAPIToken(data.ApiToken.ValueString()),
 		opslevel.SetURL(data.ApiUrl.ValueString()),
 		opslevel.SetTimeout(time.Second * time.Duration(data.ApiTimeout.ValueInt64())),
 		opslevel.SetUserAgentExtra(fmt.Sprintf("terraform-provider-%s", p.version)),
+		// retries are handled by the rate limited transport, which also honours Retry-After
+		opslevel.SetMaxRetries(0),
+	}
+	// the client is cheap to build, so each resource and data source gets its own that logs with its context
+	// and whose requests are cancelled with it
+	newClient := func(ctx context.Context) *opslevel.Client {
+		httpClient := &http.Client{Transport: newGraphQLLogTransport(ctx, transport)}
+		return opslevel.NewGQLClient(append(slices.Clone(opts), opslevel.SetHTTPClient(httpClient))...)
 	}
-	client := opslevel.NewGQLClient(opts...)
+	client := newClient(ctx)
 
-	tflog.Debug(ctx, "Validating OpsLevel client...")
-	if err := client.Validate(); err != nil {
-		tflog.Error(ctx, fmt.Sprintf("OpsLevel client validation error: %s", err))
+	if data.SkipCredentialsValidation.ValueBool() {
+		tflog.Info(ctx, "Skipping OpsLevel credentials validation")
+	} else {
+		tflog.Debug(ctx, "Validating OpsLevel client...")
+		validateCredentials(ctx, client, data.ApiUrl.ValueString(), tokenSource, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		tflog.Debug(ctx, "OpsLevel client is valid")
 	}
-	tflog.Debug(ctx, "OpsLevel client is valid")
 	tflog.Info(ctx, "OpsLevel client is initialized")
 
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	tags, diags := newTagConfig(ctx, data.DefaultTags, data.IgnoreTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sharedData := &providerData{
+		newClient:          newClient,
+		cache:              newLookupCache(),
+		tags:               tags,
+		readOnly:           data.ReadOnly.ValueBool(),
+		validateReferences: data.ValidateReferences.ValueBool(),
+	}
+	resp.DataSourceData = sharedData
+	resp.EphemeralResourceData = sharedData
+	resp.ListResourceData = sharedData
+	resp.ResourceData = sharedData
 }
 
 func (p *OpslevelProvider) Resources(context.Context) []func() resource.Resource {
@@ -225,18 +673,70 @@ func (p *OpslevelProvider) Resources(context.Context) []func() resource.Resource
 	}
 }
 
+func (p *OpslevelProvider) EphemeralResources(context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewSecretEphemeralResource,
+	}
+}
+
+func (p *OpslevelProvider) ListResources(context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewCheckAlertSourceUsageListResource,
+		NewCheckCodeIssueListResource,
+		NewCheckCustomEventListResource,
+		NewCheckGitBranchProtectionListResource,
+		NewCheckHasDocumentationListResource,
+		NewCheckHasRecentDeployListResource,
+		NewCheckManualListResource,
+		NewCheckPackageVersionListResource,
+		NewCheckRepositoryFileListResource,
+		NewCheckRepositoryGrepListResource,
+		NewCheckRepositoryIntegratedListResource,
+		NewCheckRepositorySearchListResource,
+		NewCheckRelationshipListResource,
+		NewCheckServiceConfigurationListResource,
+		NewCheckServiceDependencyListResource,
+		NewCheckServiceOwnershipListResource,
+		NewCheckServicePropertyListResource,
+		NewCheckTagDefinedListResource,
+		NewCheckToolUsageListResource,
+		NewDomainListResource,
+		NewFilterListResource,
+		NewInfrastructureListResource,
+		NewPropertyDefinitionListResource,
+		NewServiceListResource,
+		NewSystemListResource,
+		NewTeamListResource,
+	}
+}
+
+func (p *OpslevelProvider) Functions(context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewBuildManagementPropertyFunction,
+		NewFormatTagFunction,
+		NewIsIdFunction,
+		NewParseManagementPropertyFunction,
+		NewParseTagFunction,
+	}
+}
+
 func (p *OpslevelProvider) DataSources(context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewAliasLookupDataSource,
 		NewCampaignDataSource,
 		NewCampaignDataSourcesAll,
 		NewComponentTypeDataSourceSingle,
 		NewComponentTypeDataSourceMulti,
 		NewCategoryDataSource,
 		NewCategoryDataSourcesAll,
+		NewCheckDataSource,
+		NewCheckDataSourcesAll,
 		NewDomainDataSource,
 		NewDomainDataSourcesAll,
 		NewFilterDataSource,
 		NewFilterDataSourcesAll,
+		NewInfrastructureDataSource,
+		NewInfrastructureDataSourcesAll,
 		NewIntegrationDataSource,
 		NewIntegrationDataSourcesAll,
 		NewLevelDataSource,
@@ -251,8 +751,10 @@ func (p *OpslevelProvider) DataSources(context.Context) []func() datasource.Data
 		NewRepositoryDataSource,
 		NewScorecardDataSource,
 		NewScorecardDataSourcesAll,
+		NewScorecardReportDataSource,
 		NewServiceDataSource,
 		NewServiceDependenciesDataSource,
+		NewServiceMaturityDataSource,
 		NewServiceDataSourcesAll,
 		NewSystemDataSource,
 		NewSystemDataSourcesAll,
@@ -0,0 +1,209 @@
+package opslevel
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultMaxRequestsPerMinute = int64(400)
+	defaultMaxRetries           = int64(5)
+	defaultRetryMaxWait         = int64(60)
+
+	retryBaseWait = time.Second
+)
+
+// tokenBucket is a simple token bucket limiter shared by every request the provider sends
+type tokenBucket struct {
+	mu sync.Mutex
+
+	capacity     float64
+	tokens       float64
+	refillRate   float64 // tokens per second
+	lastRefill   time.Time
+	blockedUntil time.Time
+}
+
+// newTokenBucket expects at least one request per minute, lower rates are raised to it as a zero rate would never refill
+func newTokenBucket(requestsPerMinute int64) *tokenBucket {
+	refillRate := float64(max(requestsPerMinute, 1)) / 60
+	// allow short bursts of up to one second worth of requests
+	capacity := math.Max(1, math.Floor(refillRate))
+	return &tokenBucket{
+		capacity:   capacity,
+		tokens:     capacity,
+		refillRate: refillRate,
+		lastRefill: time.Now(),
+	}
+}
+
+// Wait blocks until a token is available or the context is done
+func (b *tokenBucket) Wait(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		now := time.Now()
+		b.tokens = math.Min(b.capacity, b.tokens+now.Sub(b.lastRefill).Seconds()*b.refillRate)
+		b.lastRefill = now
+
+		var wait time.Duration
+		switch {
+		case now.Before(b.blockedUntil):
+			wait = b.blockedUntil.Sub(now)
+		case b.tokens >= 1:
+			b.tokens--
+			b.mu.Unlock()
+			return nil
+		default:
+			wait = time.Duration((1 - b.tokens) / b.refillRate * float64(time.Second))
+		}
+		b.mu.Unlock()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// PauseFor stops handing out tokens until the given duration has passed.
+// Used when the API tells us we are being rate limited so that every in-flight resource backs off, not just one.
+func (b *tokenBucket) PauseFor(wait time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	until := time.Now().Add(wait)
+	if until.After(b.blockedUntil) {
+		b.blockedUntil = until
+	}
+	b.tokens = 0
+}
+
+// rateLimitedTransport throttles requests to the OpsLevel API and retries
+// rate limited or temporarily unavailable responses with exponential backoff
+type rateLimitedTransport struct {
+	base       http.RoundTripper
+	limiter    *tokenBucket
+	maxRetries int
+	maxWait    time.Duration
+}
+
+func newRateLimitedTransport(base http.RoundTripper, requestsPerMinute, maxRetries int64, maxWait time.Duration) *rateLimitedTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &rateLimitedTransport{
+		base:       base,
+		limiter:    newTokenBucket(requestsPerMinute),
+		maxRetries: int(maxRetries),
+		maxWait:    maxWait,
+	}
+}
+
+func (t *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		if err := t.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+
+		attemptReq, err := rewindRequest(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := t.base.RoundTrip(attemptReq)
+		if err != nil || !isRetryableStatus(resp.StatusCode) || attempt >= t.maxRetries || !canRewind(req) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		if resp.StatusCode == http.StatusTooManyRequests {
+			t.limiter.PauseFor(wait)
+		}
+		tflog.Warn(ctx, fmt.Sprintf(
+			"OpsLevel API responded with '%s', retrying in %s (attempt %d of %d)",
+			resp.Status, wait, attempt+1, t.maxRetries,
+		))
+
+		// drain the body so the underlying connection can be reused
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff returns how long to wait before the next attempt, honouring the
+// Retry-After header when the API sends one
+func (t *rateLimitedTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+		return min(wait, t.maxWait)
+	}
+
+	wait := retryBaseWait << attempt
+	if wait <= 0 || wait > t.maxWait {
+		wait = t.maxWait
+	}
+	// add up to 20% jitter so parallel resources don't retry in lockstep
+	jitter := time.Duration(rand.Int64N(int64(wait)/5 + 1))
+	return min(wait+jitter, t.maxWait)
+}
+
+func isRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter supports both forms of the Retry-After header, delay-seconds and HTTP-date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if retryAt, err := http.ParseTime(value); err == nil {
+		return max(time.Until(retryAt), 0), true
+	}
+	return 0, false
+}
+
+func canRewind(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// rewindRequest returns a copy of the request with a fresh body for retry attempts
+func rewindRequest(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 0 || req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	retryReq := req.Clone(req.Context())
+	retryReq.Body = body
+	return retryReq, nil
+}
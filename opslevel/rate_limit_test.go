@@ -0,0 +1,150 @@
+package opslevel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    string
+		expected time.Duration
+		ok       bool
+	}{
+		{name: "empty", value: "", ok: false},
+		{name: "seconds", value: "3", expected: 3 * time.Second, ok: true},
+		{name: "zero seconds", value: "0", expected: 0, ok: true},
+		{name: "negative seconds", value: "-1", ok: false},
+		{name: "http date in the past", value: "Wed, 21 Oct 2015 07:28:00 GMT", expected: 0, ok: true},
+		{name: "garbage", value: "soon", ok: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			wait, ok := parseRetryAfter(testCase.value)
+			if ok != testCase.ok {
+				t.Fatalf("expected ok to be %v, got %v", testCase.ok, ok)
+			}
+			if wait != testCase.expected {
+				t.Errorf("expected wait of %s, got %s", testCase.expected, wait)
+			}
+		})
+	}
+}
+
+func TestRateLimitedTransportRetriesTooManyRequests(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRateLimitedTransport(nil, 6000, 5, time.Second)}
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"query":"{ account { id } }"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200 after retries, got %d", resp.StatusCode)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("expected 3 requests, got %d", calls.Load())
+	}
+}
+
+func TestRateLimitedTransportStopsAfterMaxRetries(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRateLimitedTransport(nil, 6000, 2, time.Second)}
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("expected status 429 once retries are exhausted, got %d", resp.StatusCode)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("expected 1 request and 2 retries, got %d requests", calls.Load())
+	}
+}
+
+func TestTokenBucketWaitRespectsContext(t *testing.T) {
+	bucket := newTokenBucket(1)
+	if err := bucket.Wait(context.Background()); err != nil {
+		t.Fatalf("expected first token to be available, got error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := bucket.Wait(ctx); err == nil {
+		t.Error("expected an error once the bucket is empty and the context is done")
+	}
+}
+
+func TestTokenBucketNonPositiveRate(t *testing.T) {
+	for _, requestsPerMinute := range []int64{0, -10} {
+		if bucket := newTokenBucket(requestsPerMinute); bucket.refillRate <= 0 {
+			t.Errorf("expected a positive refill rate for %d requests per minute, got %f", requestsPerMinute, bucket.refillRate)
+		}
+	}
+}
+
+func TestConfigInt64(t *testing.T) {
+	testCases := []struct {
+		name        string
+		value       types.Int64
+		envValue    string
+		expected    int64
+		expectError bool
+	}{
+		{name: "default", value: types.Int64Null(), expected: 400},
+		{name: "configured", value: types.Int64Value(100), envValue: "0", expected: 100},
+		{name: "environment variable", value: types.Int64Null(), envValue: "200", expected: 200},
+		{name: "invalid environment variable", value: types.Int64Null(), envValue: "many", expected: 400},
+		{name: "zero environment variable", value: types.Int64Null(), envValue: "0", expected: 0, expectError: true},
+		{name: "negative environment variable", value: types.Int64Null(), envValue: "-5", expected: -5, expectError: true},
+		{name: "zero configured", value: types.Int64Value(0), expected: 0, expectError: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if testCase.envValue != "" {
+				t.Setenv("OPSLEVEL_MAX_REQUESTS_PER_MINUTE", testCase.envValue)
+			}
+			value := testCase.value
+			resp := &provider.ConfigureResponse{}
+			configInt64(&value, "max_requests_per_minute", "OPSLEVEL_MAX_REQUESTS_PER_MINUTE", defaultMaxRequestsPerMinute, 1, resp)
+
+			if value.ValueInt64() != testCase.expected {
+				t.Errorf("expected %d, got %d", testCase.expected, value.ValueInt64())
+			}
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected an error to be %v, got diagnostics %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package opslevel
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"regexp"
+)
+
+// graphQLMutation matches a mutation operation at the start of a document or after a previous operation
+var graphQLMutation = regexp.MustCompile(`(^|})\s*mutation\b`)
+
+var errReadOnly = errors.New("refusing to send a GraphQL mutation because the OpsLevel provider is configured with 'read_only'")
+
+// readOnlyTransport refuses to send GraphQL mutations.
+// Resources already stop before calling the client in read_only mode, this guarantees nothing else can slip through.
+type readOnlyTransport struct {
+	base http.RoundTripper
+}
+
+func newReadOnlyTransport(base http.RoundTripper) *readOnlyTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &readOnlyTransport{base: base}
+}
+
+func (t *readOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return t.base.RoundTrip(req)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	if isGraphQLMutation(body) {
+		return nil, errReadOnly
+	}
+
+	readReq := req.Clone(req.Context())
+	readReq.Body = io.NopCloser(bytes.NewReader(body))
+	readReq.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	return t.base.RoundTrip(readReq)
+}
+
+// isGraphQLMutation reports whether a request body contains a GraphQL mutation.
+// Bodies that can't be parsed are treated as mutations so read_only fails closed.
+func isGraphQLMutation(body []byte) bool {
+	var request struct {
+		Query string `json:"query"`
+	}
+	if err := json.Unmarshal(body, &request); err != nil {
+		return true
+	}
+	return graphQLMutation.MatchString(request.Query)
+}
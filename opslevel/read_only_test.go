@@ -0,0 +1,60 @@
+package opslevel
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestIsGraphQLMutation(t *testing.T) {
+	testCases := []struct {
+		name     string
+		body     string
+		expected bool
+	}{
+		{name: "query", body: `{"query":"query ($id:ID!){account{service(id: $id){id}}}"}`, expected: false},
+		{name: "anonymous query", body: `{"query":"{ account { id } }"}`, expected: false},
+		{name: "mutation", body: `{"query":"mutation ($input:ServiceCreateInput!){serviceCreate(input: $input){service{id}}}"}`, expected: true},
+		{name: "mutation after whitespace", body: `{"query":"\n  mutation { teamDelete(input: {}) { errors { message } } }"}`, expected: true},
+		{name: "mutation after a query", body: `{"query":"query A { account { id } } mutation B { teamDelete(input: {}) { errors { message } } }"}`, expected: true},
+		{name: "field named like a mutation", body: `{"query":"{ account { mutationCount } }"}`, expected: false},
+		{name: "unparseable body", body: `mutation`, expected: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if isMutation := isGraphQLMutation([]byte(testCase.body)); isMutation != testCase.expected {
+				t.Errorf("expected %v, got %v", testCase.expected, isMutation)
+			}
+		})
+	}
+}
+
+func TestReadOnlyTransport(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newReadOnlyTransport(nil)}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"query":"{ account { id } }"}`))
+	if err != nil {
+		t.Fatalf("expected query to be sent, got error: %s", err)
+	}
+	resp.Body.Close()
+
+	_, err = client.Post(server.URL, "application/json", strings.NewReader(`{"query":"mutation { teamDelete(input: {}) { errors { message } } }"}`))
+	if !errors.Is(err, errReadOnly) {
+		t.Errorf("expected mutation to be refused with errReadOnly, got: %v", err)
+	}
+
+	if calls.Load() != 1 {
+		t.Errorf("expected only the query to reach the server, got %d requests", calls.Load())
+	}
+}
@@ -0,0 +1,127 @@
+package opslevel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+// plannedReference is an attribute that holds the id or alias of another OpsLevel object
+type plannedReference struct {
+	attribute string
+	kind      lookupKind
+}
+
+// checkReferences are the objects every check refers to
+var checkReferences = []plannedReference{
+	{attribute: "category", kind: lookupKindCategory},
+	{attribute: "filter", kind: lookupKindFilter},
+	{attribute: "level", kind: lookupKindLevel},
+	{attribute: "owner", kind: lookupKindTeam},
+}
+
+// validatePlannedReferences resolves the objects referenced by the plan when the provider sets validate_references,
+// so a typo or a deleted object fails `terraform plan` instead of failing halfway through an apply.
+// Unknown values, such as the id of an object created in the same apply, can't be checked and are skipped.
+func (d *CommonResourceClient) validatePlannedReferences(ctx context.Context, plan tfsdk.Plan, diags *diag.Diagnostics, references []plannedReference) {
+	if !d.validateReferences || plan.Raw.IsNull() {
+		return
+	}
+
+	for _, reference := range references {
+		var value types.String
+		diags.Append(plan.GetAttribute(ctx, path.Root(reference.attribute), &value)...)
+		if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+			continue
+		}
+
+		identifier := value.ValueString()
+		err := d.resolveReference(reference.kind, identifier)
+		if err == nil {
+			continue
+		}
+		if isNotFound(err, false) {
+			diags.AddAttributeError(
+				path.Root(reference.attribute),
+				fmt.Sprintf("Unknown OpsLevel %s", reference.kind),
+				fmt.Sprintf("No %s with id or alias '%s' exists in the OpsLevel account, got error: %s", reference.kind, identifier, err),
+			)
+			continue
+		}
+		diags.AddAttributeWarning(
+			path.Root(reference.attribute),
+			fmt.Sprintf("Unable to validate OpsLevel %s", reference.kind),
+			fmt.Sprintf("Unable to check that the %s '%s' exists, it will be checked again during apply. Got error: %s", reference.kind, identifier, err),
+		)
+	}
+}
+
+// resolveReference returns an error when no object of the given kind has the given id or alias
+func (d *CommonResourceClient) resolveReference(kind lookupKind, identifier string) error {
+	switch kind {
+	case lookupKindCategory:
+		return cachedReference(d.cache, kind, identifier, func() (bool, error) {
+			category, err := d.client.GetCategory(opslevel.ID(identifier))
+			return category != nil && category.Id != "", err
+		})
+	case lookupKindFilter:
+		return cachedReference(d.cache, kind, identifier, func() (bool, error) {
+			filter, err := d.client.GetFilter(opslevel.ID(identifier))
+			return filter != nil && filter.Id != "", err
+		})
+	case lookupKindLevel:
+		return cachedReference(d.cache, kind, identifier, func() (bool, error) {
+			level, err := d.client.GetLevel(opslevel.ID(identifier))
+			return level != nil && level.Id != "", err
+		})
+	case lookupKindTeam:
+		if !opslevel.IsID(identifier) {
+			_, err := d.cache.resolveTeamID(d.client, identifier)
+			return err
+		}
+		return cachedReference(d.cache, kind, identifier, func() (bool, error) {
+			team, err := d.client.GetTeam(opslevel.ID(identifier))
+			return team != nil && team.Id != "", err
+		})
+	case lookupKindLifecycle:
+		lifecycles, err := d.cache.listLifecycles(d.client)
+		if err != nil {
+			return err
+		}
+		for _, lifecycle := range lifecycles {
+			if lifecycle.Alias == identifier {
+				return nil
+			}
+		}
+		return fmt.Errorf("lifecycle with alias '%s' not found", identifier)
+	case lookupKindTier:
+		tiers, err := d.cache.listTiers(d.client)
+		if err != nil {
+			return err
+		}
+		for _, tier := range tiers {
+			if tier.Alias == identifier {
+				return nil
+			}
+		}
+		return fmt.Errorf("tier with alias '%s' not found", identifier)
+	}
+	return fmt.Errorf("unsupported reference kind '%s'", kind)
+}
+
+// cachedReference remembers that an object was found, so checks sharing a category or filter look it up once per run
+func cachedReference(c *lookupCache, kind lookupKind, identifier string, find func() (bool, error)) error {
+	_, err := cachedLookup(c, kind, "exists:"+identifier, func() (bool, error) {
+		found, err := find()
+		if err == nil && !found {
+			err = fmt.Errorf("%s with id '%s' not found", kind, identifier)
+		}
+		return found, err
+	})
+	return err
+}
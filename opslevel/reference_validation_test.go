@@ -0,0 +1,43 @@
+package opslevel
+
+import (
+	"testing"
+
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+func TestResolveReferenceAliases(t *testing.T) {
+	cache := newLookupCache()
+	// seed the cache so the lookups never reach the API
+	_, _ = cachedLookup(cache, lookupKindLifecycle, "", func() ([]opslevel.Lifecycle, error) {
+		return []opslevel.Lifecycle{{Alias: "generally_available"}}, nil
+	})
+	_, _ = cachedLookup(cache, lookupKindTier, "", func() ([]opslevel.Tier, error) {
+		return []opslevel.Tier{{Alias: "tier_1"}}, nil
+	})
+	client := CommonResourceClient{cache: cache}
+
+	testCases := []struct {
+		name       string
+		kind       lookupKind
+		identifier string
+		found      bool
+	}{
+		{name: "lifecycle", kind: lookupKindLifecycle, identifier: "generally_available", found: true},
+		{name: "unknown lifecycle", kind: lookupKindLifecycle, identifier: "generaly_available", found: false},
+		{name: "tier", kind: lookupKindTier, identifier: "tier_1", found: true},
+		{name: "unknown tier", kind: lookupKindTier, identifier: "tier_9", found: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := client.resolveReference(testCase.kind, testCase.identifier)
+			if testCase.found && err != nil {
+				t.Errorf("expected '%s' to be found, got error: %s", testCase.identifier, err)
+			}
+			if !testCase.found && (err == nil || !isNotFound(err, false)) {
+				t.Errorf("expected a not found error for '%s', got: %v", testCase.identifier, err)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -19,6 +20,8 @@ import (
 
 var _ resource.ResourceWithConfigure = &AliasResource{}
 
+var _ resource.ResourceWithIdentity = &AliasResource{}
+
 func NewAliasResource() resource.Resource {
 	return &AliasResource{}
 }
@@ -40,6 +43,19 @@ type AliasResourceModel struct {
 	Id types.String `tfsdk:"id"`
 }
 
+// aliasIdentityModel identifies the aliases managed on a single resource
+type aliasIdentityModel struct {
+	ResourceIdentifier types.String `tfsdk:"resource_identifier"`
+	ResourceType       types.String `tfsdk:"resource_type"`
+}
+
+func (s AliasResourceModel) identity() aliasIdentityModel {
+	return aliasIdentityModel{
+		ResourceIdentifier: s.ResourceIdentifier,
+		ResourceType:       s.ResourceType,
+	}
+}
+
 func (s AliasResourceModel) GetResource(d *diag.Diagnostics, client *opslevel.Client) opslevel.AliasableResourceInterface {
 	resourceType := opslevel.AliasOwnerTypeEnum(s.ResourceType.ValueString())
 	resourceIdentifier := s.ResourceIdentifier.ValueString()
@@ -103,6 +119,21 @@ func (r *AliasResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+func (r *AliasResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"resource_identifier": identityschema.StringAttribute{
+				Description:       "The id or human-friendly, unique identifier of the resource the aliases belong to.",
+				RequiredForImport: true,
+			},
+			"resource_type": identityschema.StringAttribute{
+				Description:       "The type of the resource the aliases belong to.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *AliasResource) createAlias(d *diag.Diagnostics, alias string, aliasable opslevel.AliasableResourceInterface) {
 	input := opslevel.AliasCreateInput{
 		Alias:   alias,
@@ -122,6 +153,9 @@ func (r *AliasResource) deleteAlias(d *diag.Diagnostics, alias string, aliasable
 		Alias:     alias,
 		OwnerType: aliasable.AliasableType(),
 	}
+	if aliasable.AliasableType() == opslevel.AliasOwnerTypeEnumTeam {
+		r.cache.invalidate(lookupKindTeam)
+	}
 	if err := r.client.DeleteAlias(input); err != nil {
 		// This allows locked slugs to be added and not cause a failure upon delete
 		if strings.Contains(err.Error(), "slug is locked, it cannot be deleted") {
@@ -135,6 +169,10 @@ func (r *AliasResource) deleteAlias(d *diag.Diagnostics, alias string, aliasable
 }
 
 func (r *AliasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[AliasResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -164,22 +202,35 @@ func (r *AliasResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &planModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, planModel.identity())...)
 }
 
 func (r *AliasResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	planModel := read[AliasResourceModel](ctx, &resp.Diagnostics, req.State)
-
-	aliasable := planModel.GetResource(&resp.Diagnostics, r.client)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	aliasable, err := r.client.GetAliasableResource(opslevel.AliasOwnerTypeEnum(planModel.ResourceType.ValueString()), planModel.ResourceIdentifier.ValueString())
+	if removeIfNotFound(ctx, resp, err, err == nil && aliasable != nil) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Failed to find aliasable resource, %s", err))
+		return
+	}
+
 	planModel.Id = types.StringValue(string(aliasable.ResourceId()))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &planModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, planModel.identity())...)
 }
 
 func (r *AliasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[AliasResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	stateModel := read[AliasResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
@@ -222,9 +273,14 @@ func (r *AliasResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &planModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, planModel.identity())...)
 }
 
 func (r *AliasResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[AliasResourceModel](ctx, &resp.Diagnostics, req.State)
 
 	managedAliases := stateModel.GetAliases(ctx, &resp.Diagnostics)
@@ -3,7 +3,9 @@ package opslevel
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -22,6 +24,8 @@ var _ resource.ResourceWithConfigure = &CampaignResource{}
 
 var _ resource.ResourceWithImportState = &CampaignResource{}
 
+var _ resource.ResourceWithIdentity = &CampaignResource{}
+
 var _ resource.ResourceWithValidateConfig = &CampaignResource{}
 
 func NewCampaignResource() resource.Resource {
@@ -33,16 +37,25 @@ type CampaignResource struct {
 }
 
 type CampaignResourceModel struct {
-	Id           types.String `tfsdk:"id"`
-	Name         types.String `tfsdk:"name"`
-	OwnerId      types.String `tfsdk:"owner_id"`
-	FilterId     types.String `tfsdk:"filter_id"`
-	ProjectBrief types.String `tfsdk:"project_brief"`
-	CheckIds     types.List   `tfsdk:"check_ids"`
-	StartDate    types.String `tfsdk:"start_date"`
-	TargetDate   types.String `tfsdk:"target_date"`
-	Status       types.String `tfsdk:"status"`
-	HtmlUrl      types.String `tfsdk:"html_url"`
+	Id           types.String   `tfsdk:"id"`
+	Name         types.String   `tfsdk:"name"`
+	OwnerId      types.String   `tfsdk:"owner_id"`
+	FilterId     types.String   `tfsdk:"filter_id"`
+	ProjectBrief types.String   `tfsdk:"project_brief"`
+	CheckIds     types.List     `tfsdk:"check_ids"`
+	StartDate    types.String   `tfsdk:"start_date"`
+	TargetDate   types.String   `tfsdk:"target_date"`
+	Status       types.String   `tfsdk:"status"`
+	HtmlUrl      types.String   `tfsdk:"html_url"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+// campaignTimeouts leave time for OpsLevel to copy checks into the campaign
+var campaignTimeouts = operationTimeouts{
+	Create: 10 * time.Minute,
+	Read:   2 * time.Minute,
+	Update: 10 * time.Minute,
+	Delete: 2 * time.Minute,
 }
 
 func NewCampaignResourceModel(campaign opslevel.Campaign, givenModel CampaignResourceModel) CampaignResourceModel {
@@ -55,6 +68,7 @@ func NewCampaignResourceModel(campaign opslevel.Campaign, givenModel CampaignRes
 		CheckIds:     types.ListNull(types.StringType),
 		Status:       ComputedStringValue(string(campaign.Status)),
 		HtmlUrl:      ComputedStringValue(campaign.HtmlUrl),
+		Timeouts:     givenModel.Timeouts,
 	}
 
 	if !campaign.StartDate.IsZero() {
@@ -129,9 +143,16 @@ func (r *CampaignResource) Schema(ctx context.Context, req resource.SchemaReques
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, campaignTimeouts),
+		},
 	}
 }
 
+func (r *CampaignResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *CampaignResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var config CampaignResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -150,6 +171,10 @@ func (r *CampaignResource) ValidateConfig(ctx context.Context, req resource.Vali
 }
 
 func (r *CampaignResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[CampaignResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -165,7 +190,13 @@ func (r *CampaignResource) Create(ctx context.Context, req resource.CreateReques
 		input.ProjectBrief = &brief
 	}
 
-	campaign, err := r.client.CreateCampaign(input)
+	ctx, cancel, client := r.startOperation(ctx, planModel.Timeouts.Create, campaignTimeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	campaign, err := client.CreateCampaign(input)
 	if err != nil || campaign == nil {
 		title, detail := formatOpslevelError("create campaign", err)
 		resp.Diagnostics.AddError(title, detail)
@@ -179,7 +210,7 @@ func (r *CampaignResource) Create(ctx context.Context, req resource.CreateReques
 			resp.Diagnostics.AddError("invalid date", "start_date and target_date must be valid dates (YYYY-MM-DD)")
 			return
 		}
-		scheduled, err := r.client.ScheduleCampaign(opslevel.CampaignScheduleUpdateInput{
+		scheduled, err := client.ScheduleCampaign(opslevel.CampaignScheduleUpdateInput{
 			Id:         campaign.Id,
 			StartDate:  iso8601.Time{Time: startDate},
 			TargetDate: iso8601.Time{Time: targetDate},
@@ -198,7 +229,7 @@ func (r *CampaignResource) Create(ctx context.Context, req resource.CreateReques
 			return
 		}
 		if len(checkIds) > 0 {
-			updated, err := r.client.CopyChecksToCampaign(opslevel.ChecksCopyToCampaignInput{
+			updated, err := client.CopyChecksToCampaign(opslevel.ChecksCopyToCampaignInput{
 				CampaignId: campaign.Id,
 				CheckIds:   checkIds,
 			})
@@ -207,6 +238,11 @@ func (r *CampaignResource) Create(ctx context.Context, req resource.CreateReques
 				resp.Diagnostics.AddError(title, detail)
 				return
 			}
+			if err := waitForCampaignChecks(ctx, client, campaign.Id, checkIds); err != nil {
+				title, detail := formatOpslevelError("wait for checks to be copied to campaign", err)
+				resp.Diagnostics.AddError(title, detail)
+				return
+			}
 			campaign = updated
 		}
 	}
@@ -217,6 +253,7 @@ func (r *CampaignResource) Create(ctx context.Context, req resource.CreateReques
 	}
 	tflog.Trace(ctx, "created a campaign resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &createdModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: createdModel.Id})...)
 }
 
 func (r *CampaignResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -225,26 +262,35 @@ func (r *CampaignResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	campaign, err := r.client.GetCampaign(opslevel.ID(stateModel.Id.ValueString()))
-	if err != nil || campaign == nil {
-		if (campaign == nil || campaign.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
+	ctx, cancel, client := r.startOperation(ctx, stateModel.Timeouts.Read, campaignTimeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	campaign, err := client.GetCampaign(opslevel.ID(stateModel.Id.ValueString()))
+	if removeIfNotFound(ctx, resp, err, campaign != nil && campaign.Id != "") {
+		return
+	}
+	if err != nil {
 		title, detail := formatOpslevelError("read campaign", err)
 		resp.Diagnostics.AddError(title, detail)
 		return
 	}
 
 	readModel := NewCampaignResourceModel(*campaign, stateModel)
-	readModel.CheckIds = r.readCampaignCheckIds(ctx, &resp.Diagnostics, campaign.Id, stateModel.CheckIds)
+	readModel.CheckIds = r.readCampaignCheckIds(ctx, client, &resp.Diagnostics, campaign.Id, stateModel.CheckIds)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &readModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: readModel.Id})...)
 }
 
 func (r *CampaignResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[CampaignResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	stateModel := read[CampaignResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
@@ -267,7 +313,13 @@ func (r *CampaignResource) Update(ctx context.Context, req resource.UpdateReques
 	brief := planModel.ProjectBrief.ValueString()
 	updateInput.ProjectBrief = &brief
 
-	campaign, err := r.client.UpdateCampaign(updateInput)
+	ctx, cancel, client := r.startOperation(ctx, planModel.Timeouts.Update, campaignTimeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	campaign, err := client.UpdateCampaign(updateInput)
 	if err != nil {
 		title, detail := formatOpslevelError("update campaign", err)
 		resp.Diagnostics.AddError(title, detail)
@@ -284,7 +336,7 @@ func (r *CampaignResource) Update(ctx context.Context, req resource.UpdateReques
 			resp.Diagnostics.AddError("invalid date", "start_date and target_date must be valid dates (YYYY-MM-DD)")
 			return
 		}
-		scheduled, err := r.client.ScheduleCampaign(opslevel.CampaignScheduleUpdateInput{
+		scheduled, err := client.ScheduleCampaign(opslevel.CampaignScheduleUpdateInput{
 			Id:         campaignId,
 			StartDate:  iso8601.Time{Time: startDate},
 			TargetDate: iso8601.Time{Time: targetDate},
@@ -296,7 +348,7 @@ func (r *CampaignResource) Update(ctx context.Context, req resource.UpdateReques
 		}
 		campaign = scheduled
 	} else if stateHasDates && !planHasDates {
-		unscheduled, err := r.client.UnscheduleCampaign(campaignId)
+		unscheduled, err := client.UnscheduleCampaign(campaignId)
 		if err != nil {
 			title, detail := formatOpslevelError("unschedule campaign", err)
 			resp.Diagnostics.AddError(title, detail)
@@ -305,7 +357,7 @@ func (r *CampaignResource) Update(ctx context.Context, req resource.UpdateReques
 		campaign = unscheduled
 	}
 
-	r.reconcileCampaignChecks(ctx, &resp.Diagnostics, campaignId, stateModel, planModel)
+	r.reconcileCampaignChecks(ctx, client, &resp.Diagnostics, campaignId, stateModel, planModel)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -316,15 +368,25 @@ func (r *CampaignResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 	tflog.Trace(ctx, "updated a campaign resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &updatedModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: updatedModel.Id})...)
 }
 
 func (r *CampaignResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[CampaignResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	err := r.client.DeleteCampaign(opslevel.ID(stateModel.Id.ValueString()))
+	ctx, cancel, client := r.startOperation(ctx, stateModel.Timeouts.Delete, campaignTimeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := client.DeleteCampaign(opslevel.ID(stateModel.Id.ValueString()))
 	if err != nil {
 		title, detail := formatOpslevelError("delete campaign", err)
 		resp.Diagnostics.AddError(title, detail)
@@ -334,7 +396,7 @@ func (r *CampaignResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *CampaignResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // readCampaignCheckIds queries the campaign's actual checks from the API and
@@ -343,6 +405,7 @@ func (r *CampaignResource) ImportState(ctx context.Context, req resource.ImportS
 // removed outside Terraform.
 func (r *CampaignResource) readCampaignCheckIds(
 	ctx context.Context,
+	client *opslevel.Client,
 	diags *diag.Diagnostics,
 	campaignId opslevel.ID,
 	priorCheckIds types.List,
@@ -360,7 +423,7 @@ func (r *CampaignResource) readCampaignCheckIds(
 		return priorCheckIds
 	}
 
-	campaignChecks, err := r.client.ListCampaignChecks(campaignId)
+	campaignChecks, err := client.ListCampaignChecks(campaignId)
 	if err != nil {
 		title, detail := formatOpslevelError("list campaign checks for read", err)
 		diags.AddError(title, detail)
@@ -380,7 +443,7 @@ func (r *CampaignResource) readCampaignCheckIds(
 
 	var verified []string
 	for _, rubricID := range priorIds {
-		check, err := r.client.GetCheck(opslevel.ID(rubricID))
+		check, err := client.GetCheck(opslevel.ID(rubricID))
 		if err != nil {
 			tflog.Warn(ctx, "could not look up rubric check during read, keeping in state",
 				map[string]any{"rubric_check_id": rubricID, "error": err.Error()})
@@ -423,6 +486,7 @@ func DiffCheckIds(stateIds, planIds map[string]bool) (toAdd []string, toRemove [
 
 func (r *CampaignResource) reconcileCampaignChecks(
 	ctx context.Context,
+	client *opslevel.Client,
 	diags *diag.Diagnostics,
 	campaignId opslevel.ID,
 	stateModel CampaignResourceModel,
@@ -448,7 +512,7 @@ func (r *CampaignResource) reconcileCampaignChecks(
 	if len(toRemove) > 0 {
 		rubricNamesByID := make(map[string]string, len(toRemove))
 		for _, rubricID := range toRemove {
-			check, err := r.client.GetCheck(opslevel.ID(rubricID))
+			check, err := client.GetCheck(opslevel.ID(rubricID))
 			if err != nil {
 				diags.AddWarning(
 					"could not look up rubric check",
@@ -459,7 +523,7 @@ func (r *CampaignResource) reconcileCampaignChecks(
 			rubricNamesByID[rubricID] = check.Name
 		}
 
-		campaignChecks, err := r.client.ListCampaignChecks(campaignId)
+		campaignChecks, err := client.ListCampaignChecks(campaignId)
 		if err != nil {
 			title, detail := formatOpslevelError("list campaign checks", err)
 			diags.AddError(title, detail)
@@ -485,7 +549,7 @@ func (r *CampaignResource) reconcileCampaignChecks(
 				tflog.Warn(ctx, "campaign check not found for removal", map[string]any{"check_name": name})
 				continue
 			}
-			if err := r.client.DeleteCheck(ccID); err != nil {
+			if err := client.DeleteCheck(ccID); err != nil {
 				title, detail := formatOpslevelError("delete campaign check", err)
 				diags.AddError(title, detail)
 				return
@@ -495,7 +559,7 @@ func (r *CampaignResource) reconcileCampaignChecks(
 	}
 
 	if len(toAdd) > 0 {
-		_, err := r.client.CopyChecksToCampaign(opslevel.ChecksCopyToCampaignInput{
+		_, err := client.CopyChecksToCampaign(opslevel.ChecksCopyToCampaignInput{
 			CampaignId: campaignId,
 			CheckIds:   toAdd,
 		})
@@ -504,10 +568,45 @@ func (r *CampaignResource) reconcileCampaignChecks(
 			diags.AddError(title, detail)
 			return
 		}
+		if err := waitForCampaignChecks(ctx, client, campaignId, toAdd); err != nil {
+			title, detail := formatOpslevelError("wait for checks to be copied to campaign", err)
+			diags.AddError(title, detail)
+			return
+		}
 		tflog.Info(ctx, "added checks to campaign", map[string]any{"count": len(toAdd)})
 	}
 }
 
+// waitForCampaignChecks polls until every copied rubric check shows up in the campaign.
+// Checks are matched by name, the same way readCampaignCheckIds finds them.
+func waitForCampaignChecks(ctx context.Context, client *opslevel.Client, campaignId opslevel.ID, checkIds []opslevel.ID) error {
+	names := make([]string, 0, len(checkIds))
+	for _, checkId := range checkIds {
+		check, err := client.GetCheck(checkId)
+		if err != nil {
+			return err
+		}
+		names = append(names, check.Name)
+	}
+
+	return waitFor(ctx, func() (bool, error) {
+		campaignChecks, err := client.ListCampaignChecks(campaignId)
+		if err != nil {
+			return false, err
+		}
+		copied := make(map[string]bool, len(campaignChecks))
+		for _, campaignCheck := range campaignChecks {
+			copied[campaignCheck.Name] = true
+		}
+		for _, name := range names {
+			if !copied[name] {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
+
 func extractCheckIdSet(ctx context.Context, diags *diag.Diagnostics, list types.List) map[string]bool {
 	if list.IsNull() || list.IsUnknown() {
 		return map[string]bool{}
@@ -21,6 +21,8 @@ import (
 var (
 	_ resource.ResourceWithConfigure      = &CheckAlertSourceUsageResource{}
 	_ resource.ResourceWithImportState    = &CheckAlertSourceUsageResource{}
+	_ resource.ResourceWithIdentity       = &CheckAlertSourceUsageResource{}
+	_ resource.ResourceWithModifyPlan     = &CheckAlertSourceUsageResource{}
 	_ resource.ResourceWithValidateConfig = &CheckAlertSourceUsageResource{}
 )
 
@@ -30,7 +32,7 @@ func NewCheckAlertSourceUsageResource() resource.Resource {
 
 // CheckAlertSourceUsageResource defines the resource implementation.
 type CheckAlertSourceUsageResource struct {
-	CommonResourceClient
+	CheckResourceClient
 }
 
 type CheckAlertSourceUsageResourceModel struct {
@@ -102,6 +104,10 @@ func (r *CheckAlertSourceUsageResource) Schema(ctx context.Context, req resource
 	}
 }
 
+func (r *CheckAlertSourceUsageResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *CheckAlertSourceUsageResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	return map[int64]resource.StateUpgrader{
 		// State upgrade implementation from 0 (prior state version) to 1 (Schema.Version)
@@ -171,6 +177,10 @@ func (r *CheckAlertSourceUsageResource) ValidateConfig(ctx context.Context, req
 }
 
 func (r *CheckAlertSourceUsageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[CheckAlertSourceUsageResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -218,6 +228,7 @@ func (r *CheckAlertSourceUsageResource) Create(ctx context.Context, req resource
 
 	tflog.Trace(ctx, "created a check alert source usage resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckAlertSourceUsageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -227,11 +238,10 @@ func (r *CheckAlertSourceUsageResource) Read(ctx context.Context, req resource.R
 	}
 
 	data, err := r.client.GetCheck(asID(stateModel.Id))
+	if removeIfNotFound(ctx, resp, err, data != nil && data.Id != "") {
+		return
+	}
 	if err != nil {
-		if (data == nil || data.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read check alert source usage, got error: %s", err))
 		return
 	}
@@ -239,9 +249,14 @@ func (r *CheckAlertSourceUsageResource) Read(ctx context.Context, req resource.R
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *CheckAlertSourceUsageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[CheckAlertSourceUsageResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -290,9 +305,14 @@ func (r *CheckAlertSourceUsageResource) Update(ctx context.Context, req resource
 
 	tflog.Trace(ctx, "updated a check alert source usage resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckAlertSourceUsageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[CheckAlertSourceUsageResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -307,5 +327,5 @@ func (r *CheckAlertSourceUsageResource) Delete(ctx context.Context, req resource
 }
 
 func (r *CheckAlertSourceUsageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
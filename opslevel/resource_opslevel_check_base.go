@@ -1,6 +1,7 @@
 package opslevel
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -17,6 +19,16 @@ import (
 	"github.com/opslevel/opslevel-go/v2026"
 )
 
+// CheckResourceClient is embedded by every check resource
+type CheckResourceClient struct {
+	CommonResourceClient
+}
+
+// ModifyPlan checks that the category, level, owner and filter of the check exist when the provider sets validate_references
+func (r *CheckResourceClient) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.validatePlannedReferences(ctx, req.Plan, &resp.Diagnostics, checkReferences)
+}
+
 type CheckCodeBaseResourceModel struct {
 	Category    types.String `tfsdk:"category"`
 	Description types.String `tfsdk:"description"`
@@ -21,6 +21,8 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = &CheckCodeIssueResource{}
 	_ resource.ResourceWithImportState = &CheckCodeIssueResource{}
+	_ resource.ResourceWithIdentity    = &CheckCodeIssueResource{}
+	_ resource.ResourceWithModifyPlan  = &CheckCodeIssueResource{}
 )
 
 func NewCheckCodeIssueResource() resource.Resource {
@@ -29,7 +31,7 @@ func NewCheckCodeIssueResource() resource.Resource {
 
 // CheckCodeIssueResource defines the resource implementation.
 type CheckCodeIssueResource struct {
-	CommonResourceClient
+	CheckResourceClient
 }
 
 var resolutionTimeType = map[string]attr.Type{
@@ -152,7 +154,15 @@ func (r *CheckCodeIssueResource) Schema(ctx context.Context, req resource.Schema
 	}
 }
 
+func (r *CheckCodeIssueResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *CheckCodeIssueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[CheckCodeIssueResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -205,6 +215,7 @@ func (r *CheckCodeIssueResource) Create(ctx context.Context, req resource.Create
 
 	tflog.Trace(ctx, "created a check_code_issue resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckCodeIssueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -214,11 +225,10 @@ func (r *CheckCodeIssueResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	data, err := r.client.GetCheck(asID(stateModel.Id))
+	if removeIfNotFound(ctx, resp, err, data != nil && data.Id != "") {
+		return
+	}
 	if err != nil {
-		if (data == nil || data.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read check_code_issue, got error: %s", err))
 		return
 	}
@@ -226,9 +236,14 @@ func (r *CheckCodeIssueResource) Read(ctx context.Context, req resource.ReadRequ
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckCodeIssueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[CheckCodeIssueResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -288,9 +303,14 @@ func (r *CheckCodeIssueResource) Update(ctx context.Context, req resource.Update
 
 	tflog.Trace(ctx, "updated a check_code_issue resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckCodeIssueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[CheckCodeIssueResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -305,5 +325,5 @@ func (r *CheckCodeIssueResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *CheckCodeIssueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
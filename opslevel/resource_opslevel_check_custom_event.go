@@ -16,6 +16,8 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = &CheckCustomEventResource{}
 	_ resource.ResourceWithImportState = &CheckCustomEventResource{}
+	_ resource.ResourceWithIdentity    = &CheckCustomEventResource{}
+	_ resource.ResourceWithModifyPlan  = &CheckCustomEventResource{}
 )
 
 func NewCheckCustomEventResource() resource.Resource {
@@ -24,7 +26,7 @@ func NewCheckCustomEventResource() resource.Resource {
 
 // CheckCustomEventResource defines the resource implementation.
 type CheckCustomEventResource struct {
-	CommonResourceClient
+	CheckResourceClient
 }
 
 type CheckCustomEventResourceModel struct {
@@ -112,7 +114,15 @@ func (r *CheckCustomEventResource) Schema(ctx context.Context, req resource.Sche
 	}
 }
 
+func (r *CheckCustomEventResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *CheckCustomEventResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[CheckCustomEventResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -151,6 +161,7 @@ func (r *CheckCustomEventResource) Create(ctx context.Context, req resource.Crea
 
 	tflog.Trace(ctx, "created a check custom event resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckCustomEventResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -160,11 +171,10 @@ func (r *CheckCustomEventResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	data, err := r.client.GetCheck(asID(stateModel.Id))
+	if removeIfNotFound(ctx, resp, err, data != nil && data.Id != "") {
+		return
+	}
 	if err != nil {
-		if (data == nil || data.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read check custom event, got error: %s", err))
 		return
 	}
@@ -172,9 +182,14 @@ func (r *CheckCustomEventResource) Read(ctx context.Context, req resource.ReadRe
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *CheckCustomEventResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[CheckCustomEventResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -214,9 +229,14 @@ func (r *CheckCustomEventResource) Update(ctx context.Context, req resource.Upda
 
 	tflog.Trace(ctx, "updated a check custom event resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckCustomEventResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[CheckCustomEventResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -231,5 +251,5 @@ func (r *CheckCustomEventResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *CheckCustomEventResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
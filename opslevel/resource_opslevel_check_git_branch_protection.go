@@ -15,6 +15,8 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = &CheckGitBranchProtectionResource{}
 	_ resource.ResourceWithImportState = &CheckGitBranchProtectionResource{}
+	_ resource.ResourceWithIdentity    = &CheckGitBranchProtectionResource{}
+	_ resource.ResourceWithModifyPlan  = &CheckGitBranchProtectionResource{}
 )
 
 func NewCheckGitBranchProtectionResource() resource.Resource {
@@ -23,7 +25,7 @@ func NewCheckGitBranchProtectionResource() resource.Resource {
 
 // CheckGitBranchProtectionResource defines the resource implementation.
 type CheckGitBranchProtectionResource struct {
-	CommonResourceClient
+	CheckResourceClient
 }
 
 func (r *CheckGitBranchProtectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -39,7 +41,15 @@ func (r *CheckGitBranchProtectionResource) Schema(ctx context.Context, req resou
 	}
 }
 
+func (r *CheckGitBranchProtectionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *CheckGitBranchProtectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[CheckCodeBaseResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -72,6 +82,7 @@ func (r *CheckGitBranchProtectionResource) Create(ctx context.Context, req resou
 
 	tflog.Trace(ctx, "created a check git branch protection resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckGitBranchProtectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -81,11 +92,10 @@ func (r *CheckGitBranchProtectionResource) Read(ctx context.Context, req resourc
 	}
 
 	data, err := r.client.GetCheck(asID(planModel.Id))
+	if removeIfNotFound(ctx, resp, err, data != nil && data.Id != "") {
+		return
+	}
 	if err != nil {
-		if (data == nil || data.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read check git branch protection, got error: %s", err))
 		return
 	}
@@ -93,9 +103,14 @@ func (r *CheckGitBranchProtectionResource) Read(ctx context.Context, req resourc
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *CheckGitBranchProtectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[CheckCodeBaseResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -129,9 +144,14 @@ func (r *CheckGitBranchProtectionResource) Update(ctx context.Context, req resou
 
 	tflog.Trace(ctx, "updated a check git branch protection resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckGitBranchProtectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[CheckCodeBaseResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -146,5 +166,5 @@ func (r *CheckGitBranchProtectionResource) Delete(ctx context.Context, req resou
 }
 
 func (r *CheckGitBranchProtectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
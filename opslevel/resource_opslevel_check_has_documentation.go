@@ -19,6 +19,8 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = &CheckHasDocumentationResource{}
 	_ resource.ResourceWithImportState = &CheckHasDocumentationResource{}
+	_ resource.ResourceWithIdentity    = &CheckHasDocumentationResource{}
+	_ resource.ResourceWithModifyPlan  = &CheckHasDocumentationResource{}
 )
 
 func NewCheckHasDocumentationResource() resource.Resource {
@@ -27,7 +29,7 @@ func NewCheckHasDocumentationResource() resource.Resource {
 
 // CheckHasDocumentationResource defines the resource implementation.
 type CheckHasDocumentationResource struct {
-	CommonResourceClient
+	CheckResourceClient
 }
 
 type CheckHasDocumentationResourceModel struct {
@@ -100,7 +102,15 @@ func (r *CheckHasDocumentationResource) Schema(ctx context.Context, req resource
 	}
 }
 
+func (r *CheckHasDocumentationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *CheckHasDocumentationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[CheckHasDocumentationResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -136,6 +146,7 @@ func (r *CheckHasDocumentationResource) Create(ctx context.Context, req resource
 
 	tflog.Trace(ctx, "created a check has documentation resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckHasDocumentationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -145,11 +156,10 @@ func (r *CheckHasDocumentationResource) Read(ctx context.Context, req resource.R
 	}
 
 	data, err := r.client.GetCheck(asID(stateModel.Id))
+	if removeIfNotFound(ctx, resp, err, data != nil && data.Id != "") {
+		return
+	}
 	if err != nil {
-		if (data == nil || data.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read check has documentation, got error: %s", err))
 		return
 	}
@@ -158,9 +168,14 @@ func (r *CheckHasDocumentationResource) Read(ctx context.Context, req resource.R
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *CheckHasDocumentationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[CheckHasDocumentationResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -197,9 +212,14 @@ func (r *CheckHasDocumentationResource) Update(ctx context.Context, req resource
 
 	tflog.Trace(ctx, "updated a check has documentation resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckHasDocumentationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[CheckHasDocumentationResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -214,5 +234,5 @@ func (r *CheckHasDocumentationResource) Delete(ctx context.Context, req resource
 }
 
 func (r *CheckHasDocumentationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -16,6 +16,8 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = &CheckHasRecentDeployResource{}
 	_ resource.ResourceWithImportState = &CheckHasRecentDeployResource{}
+	_ resource.ResourceWithIdentity    = &CheckHasRecentDeployResource{}
+	_ resource.ResourceWithModifyPlan  = &CheckHasRecentDeployResource{}
 )
 
 func NewCheckHasRecentDeployResource() resource.Resource {
@@ -24,7 +26,7 @@ func NewCheckHasRecentDeployResource() resource.Resource {
 
 // CheckHasRecentDeployResource defines the resource implementation.
 type CheckHasRecentDeployResource struct {
-	CommonResourceClient
+	CheckResourceClient
 }
 
 type CheckHasRecentDeployResourceModel struct {
@@ -79,7 +81,15 @@ func (r *CheckHasRecentDeployResource) Schema(ctx context.Context, req resource.
 	}
 }
 
+func (r *CheckHasRecentDeployResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *CheckHasRecentDeployResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[CheckHasRecentDeployResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -113,6 +123,7 @@ func (r *CheckHasRecentDeployResource) Create(ctx context.Context, req resource.
 
 	tflog.Trace(ctx, "created a check has recent deploy resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckHasRecentDeployResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -122,11 +133,10 @@ func (r *CheckHasRecentDeployResource) Read(ctx context.Context, req resource.Re
 	}
 
 	data, err := r.client.GetCheck(asID(stateModel.Id))
+	if removeIfNotFound(ctx, resp, err, data != nil && data.Id != "") {
+		return
+	}
 	if err != nil {
-		if (data == nil || data.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read check has recent deploy, got error: %s", err))
 		return
 	}
@@ -134,9 +144,14 @@ func (r *CheckHasRecentDeployResource) Read(ctx context.Context, req resource.Re
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *CheckHasRecentDeployResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[CheckHasRecentDeployResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -172,9 +187,14 @@ func (r *CheckHasRecentDeployResource) Update(ctx context.Context, req resource.
 
 	tflog.Trace(ctx, "updated a check has recent deploy resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckHasRecentDeployResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[CheckHasRecentDeployResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -189,5 +209,5 @@ func (r *CheckHasRecentDeployResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *CheckHasRecentDeployResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
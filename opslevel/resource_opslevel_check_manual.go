@@ -21,6 +21,8 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = &CheckManualResource{}
 	_ resource.ResourceWithImportState = &CheckManualResource{}
+	_ resource.ResourceWithIdentity    = &CheckManualResource{}
+	_ resource.ResourceWithModifyPlan  = &CheckManualResource{}
 )
 
 func NewCheckManualResource() resource.Resource {
@@ -29,7 +31,7 @@ func NewCheckManualResource() resource.Resource {
 
 // CheckManualResource defines the resource implementation.
 type CheckManualResource struct {
-	CommonResourceClient
+	CheckResourceClient
 }
 
 type CheckUpdateFrequency struct {
@@ -128,6 +130,10 @@ func (r *CheckManualResource) Schema(ctx context.Context, req resource.SchemaReq
 	}
 }
 
+func (r *CheckManualResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *CheckManualResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	return map[int64]resource.StateUpgrader{
 		// State upgrade implementation from 0 (prior state version) to 1 (Schema.Version)
@@ -201,6 +207,10 @@ func (r *CheckManualResource) UpgradeState(ctx context.Context) map[int64]resour
 }
 
 func (r *CheckManualResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[CheckManualResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -241,6 +251,7 @@ func (r *CheckManualResource) Create(ctx context.Context, req resource.CreateReq
 
 	tflog.Trace(ctx, "created a check manual resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckManualResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -250,11 +261,10 @@ func (r *CheckManualResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	data, err := r.client.GetCheck(asID(stateModel.Id))
+	if removeIfNotFound(ctx, resp, err, data != nil && data.Id != "") {
+		return
+	}
 	if err != nil {
-		if (data == nil || data.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read check manual, got error: %s", err))
 		return
 	}
@@ -262,9 +272,14 @@ func (r *CheckManualResource) Read(ctx context.Context, req resource.ReadRequest
 
 	// Save updated data into Terraform stateModel
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *CheckManualResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[CheckManualResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -306,9 +321,14 @@ func (r *CheckManualResource) Update(ctx context.Context, req resource.UpdateReq
 
 	tflog.Trace(ctx, "updated a check manual resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckManualResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[CheckManualResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -323,5 +343,5 @@ func (r *CheckManualResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *CheckManualResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
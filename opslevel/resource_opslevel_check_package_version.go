@@ -20,6 +20,8 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = &CheckPackageVersionResource{}
 	_ resource.ResourceWithImportState = &CheckPackageVersionResource{}
+	_ resource.ResourceWithIdentity    = &CheckPackageVersionResource{}
+	_ resource.ResourceWithModifyPlan  = &CheckPackageVersionResource{}
 )
 
 func NewCheckPackageVersionResource() resource.Resource {
@@ -28,7 +30,7 @@ func NewCheckPackageVersionResource() resource.Resource {
 
 // CheckPackageVersionResource defines the resource implementation.
 type CheckPackageVersionResource struct {
-	CommonResourceClient
+	CheckResourceClient
 }
 
 type CheckPackageVersionResourceModel struct {
@@ -142,6 +144,10 @@ func (r *CheckPackageVersionResource) Schema(ctx context.Context, req resource.S
 	}
 }
 
+func (r *CheckPackageVersionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *CheckPackageVersionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	packageVersionPossiblePredicateTypes := []opslevel.PredicateTypeEnum{
 		opslevel.PredicateTypeEnumDoesNotMatchRegex,
@@ -203,6 +209,10 @@ func (r *CheckPackageVersionResource) ValidateConfig(ctx context.Context, req re
 }
 
 func (r *CheckPackageVersionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[CheckPackageVersionResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -255,6 +265,7 @@ func (r *CheckPackageVersionResource) Create(ctx context.Context, req resource.C
 
 	tflog.Trace(ctx, "created a check package_version resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckPackageVersionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -264,11 +275,10 @@ func (r *CheckPackageVersionResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	data, err := r.client.GetCheck(asID(stateModel.Id))
+	if removeIfNotFound(ctx, resp, err, data != nil && data.Id != "") {
+		return
+	}
 	if err != nil {
-		if (data == nil || data.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read check package_version, got error: %s", err))
 		return
 	}
@@ -276,9 +286,14 @@ func (r *CheckPackageVersionResource) Read(ctx context.Context, req resource.Rea
 
 	// Save updated data into Terraform stateModel
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *CheckPackageVersionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[CheckPackageVersionResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	stateModel := read[CheckPackageVersionResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
@@ -354,9 +369,14 @@ func (r *CheckPackageVersionResource) Update(ctx context.Context, req resource.U
 
 	tflog.Trace(ctx, "updated a check package_version resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &validatedModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: validatedModel.Id})...)
 }
 
 func (r *CheckPackageVersionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[CheckPackageVersionResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -371,5 +391,5 @@ func (r *CheckPackageVersionResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *CheckPackageVersionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -18,6 +18,8 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = &CheckRelationshipResource{}
 	_ resource.ResourceWithImportState = &CheckRelationshipResource{}
+	_ resource.ResourceWithIdentity    = &CheckRelationshipResource{}
+	_ resource.ResourceWithModifyPlan  = &CheckRelationshipResource{}
 )
 
 func NewCheckRelationshipResource() resource.Resource {
@@ -26,7 +28,7 @@ func NewCheckRelationshipResource() resource.Resource {
 
 // CheckRelationshipResource defines the resource implementation.
 type CheckRelationshipResource struct {
-	CommonResourceClient
+	CheckResourceClient
 }
 
 type CheckRelationshipResourceModel struct {
@@ -110,7 +112,15 @@ func (r *CheckRelationshipResource) Schema(ctx context.Context, req resource.Sch
 	}
 }
 
+func (r *CheckRelationshipResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *CheckRelationshipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[CheckRelationshipResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -156,6 +166,7 @@ func (r *CheckRelationshipResource) Create(ctx context.Context, req resource.Cre
 
 	tflog.Trace(ctx, "created a check relationship resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckRelationshipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -165,11 +176,10 @@ func (r *CheckRelationshipResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	data, err := r.client.GetCheck(asID(stateModel.Id))
+	if removeIfNotFound(ctx, resp, err, data != nil && data.Id != "") {
+		return
+	}
 	if err != nil {
-		if (data == nil || data.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read check relationship, got error: %s", err))
 		return
 	}
@@ -177,9 +187,14 @@ func (r *CheckRelationshipResource) Read(ctx context.Context, req resource.ReadR
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *CheckRelationshipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[CheckRelationshipResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -226,9 +241,14 @@ func (r *CheckRelationshipResource) Update(ctx context.Context, req resource.Upd
 
 	tflog.Trace(ctx, "updated a check relationship resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckRelationshipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[CheckRelationshipResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -243,5 +263,5 @@ func (r *CheckRelationshipResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *CheckRelationshipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -18,6 +18,8 @@ import (
 var (
 	_ resource.ResourceWithConfigure      = &CheckRepositoryFileResource{}
 	_ resource.ResourceWithImportState    = &CheckRepositoryFileResource{}
+	_ resource.ResourceWithIdentity       = &CheckRepositoryFileResource{}
+	_ resource.ResourceWithModifyPlan     = &CheckRepositoryFileResource{}
 	_ resource.ResourceWithValidateConfig = &CheckRepositoryFileResource{}
 )
 
@@ -27,7 +29,7 @@ func NewCheckRepositoryFileResource() resource.Resource {
 
 // CheckRepositoryFileResource defines the resource implementation.
 type CheckRepositoryFileResource struct {
-	CommonResourceClient
+	CheckResourceClient
 }
 
 type CheckRepositoryFileResourceModel struct {
@@ -118,6 +120,10 @@ func (r *CheckRepositoryFileResource) Schema(ctx context.Context, req resource.S
 	}
 }
 
+func (r *CheckRepositoryFileResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *CheckRepositoryFileResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	return map[int64]resource.StateUpgrader{
 		// State upgrade implementation from 0 (prior state version) to 1 (Schema.Version)
@@ -198,6 +204,10 @@ func (r *CheckRepositoryFileResource) ValidateConfig(ctx context.Context, req re
 }
 
 func (r *CheckRepositoryFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[CheckRepositoryFileResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -249,6 +259,7 @@ func (r *CheckRepositoryFileResource) Create(ctx context.Context, req resource.C
 
 	tflog.Trace(ctx, "created a check repository file resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckRepositoryFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -258,11 +269,10 @@ func (r *CheckRepositoryFileResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	data, err := r.client.GetCheck(asID(stateModel.Id))
+	if removeIfNotFound(ctx, resp, err, data != nil && data.Id != "") {
+		return
+	}
 	if err != nil {
-		if (data == nil || data.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read check repository file, got error: %s", err))
 		return
 	}
@@ -271,9 +281,14 @@ func (r *CheckRepositoryFileResource) Read(ctx context.Context, req resource.Rea
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *CheckRepositoryFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[CheckRepositoryFileResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -332,9 +347,14 @@ func (r *CheckRepositoryFileResource) Update(ctx context.Context, req resource.U
 
 	tflog.Trace(ctx, "updated a check repository file resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckRepositoryFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[CheckRepositoryFileResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -349,5 +369,5 @@ func (r *CheckRepositoryFileResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *CheckRepositoryFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -19,6 +19,8 @@ import (
 var (
 	_ resource.ResourceWithConfigure      = &CheckRepositoryGrepResource{}
 	_ resource.ResourceWithImportState    = &CheckRepositoryGrepResource{}
+	_ resource.ResourceWithIdentity       = &CheckRepositoryGrepResource{}
+	_ resource.ResourceWithModifyPlan     = &CheckRepositoryGrepResource{}
 	_ resource.ResourceWithValidateConfig = &CheckRepositoryGrepResource{}
 )
 
@@ -28,7 +30,7 @@ func NewCheckRepositoryGrepResource() resource.Resource {
 
 // CheckRepositoryGrepResource defines the resource implementation.
 type CheckRepositoryGrepResource struct {
-	CommonResourceClient
+	CheckResourceClient
 }
 
 type CheckRepositoryGrepResourceModel struct {
@@ -113,6 +115,10 @@ func (r *CheckRepositoryGrepResource) Schema(ctx context.Context, req resource.S
 	}
 }
 
+func (r *CheckRepositoryGrepResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *CheckRepositoryGrepResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	return map[int64]resource.StateUpgrader{
 		// State upgrade implementation from 0 (prior state version) to 1 (Schema.Version)
@@ -196,6 +202,10 @@ func (r *CheckRepositoryGrepResource) ValidateConfig(ctx context.Context, req re
 }
 
 func (r *CheckRepositoryGrepResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[CheckRepositoryGrepResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -235,6 +245,7 @@ func (r *CheckRepositoryGrepResource) Create(ctx context.Context, req resource.C
 
 	tflog.Trace(ctx, "created a check repository grep resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckRepositoryGrepResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -244,11 +255,10 @@ func (r *CheckRepositoryGrepResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	data, err := r.client.GetCheck(asID(stateModel.Id))
+	if removeIfNotFound(ctx, resp, err, data != nil && data.Id != "") {
+		return
+	}
 	if err != nil {
-		if (data == nil || data.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read check repository grep, got error: %s", err))
 		return
 	}
@@ -256,9 +266,14 @@ func (r *CheckRepositoryGrepResource) Read(ctx context.Context, req resource.Rea
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *CheckRepositoryGrepResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[CheckRepositoryGrepResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -306,9 +321,14 @@ func (r *CheckRepositoryGrepResource) Update(ctx context.Context, req resource.U
 
 	tflog.Trace(ctx, "updated a check repository grep resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckRepositoryGrepResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[CheckRepositoryGrepResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -323,5 +343,5 @@ func (r *CheckRepositoryGrepResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *CheckRepositoryGrepResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -16,6 +16,8 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = &CheckRepositoryIntegratedResource{}
 	_ resource.ResourceWithImportState = &CheckRepositoryIntegratedResource{}
+	_ resource.ResourceWithIdentity    = &CheckRepositoryIntegratedResource{}
+	_ resource.ResourceWithModifyPlan  = &CheckRepositoryIntegratedResource{}
 )
 
 func NewCheckRepositoryIntegratedResource() resource.Resource {
@@ -24,7 +26,7 @@ func NewCheckRepositoryIntegratedResource() resource.Resource {
 
 // CheckRepositoryIntegratedResource defines the resource implementation.
 type CheckRepositoryIntegratedResource struct {
-	CommonResourceClient
+	CheckResourceClient
 }
 
 type CheckRepositoryIntegratedResourceModel struct {
@@ -79,7 +81,15 @@ func (r *CheckRepositoryIntegratedResource) Schema(ctx context.Context, req reso
 	}
 }
 
+func (r *CheckRepositoryIntegratedResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *CheckRepositoryIntegratedResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[CheckRepositoryIntegratedResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -112,6 +122,7 @@ func (r *CheckRepositoryIntegratedResource) Create(ctx context.Context, req reso
 
 	tflog.Trace(ctx, "created a check repository integrated resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckRepositoryIntegratedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -121,11 +132,10 @@ func (r *CheckRepositoryIntegratedResource) Read(ctx context.Context, req resour
 	}
 
 	data, err := r.client.GetCheck(asID(stateModel.Id))
+	if removeIfNotFound(ctx, resp, err, data != nil && data.Id != "") {
+		return
+	}
 	if err != nil {
-		if (data == nil || data.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read check repository integrated, got error: %s", err))
 		return
 	}
@@ -133,9 +143,14 @@ func (r *CheckRepositoryIntegratedResource) Read(ctx context.Context, req resour
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *CheckRepositoryIntegratedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[CheckRepositoryIntegratedResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -169,9 +184,14 @@ func (r *CheckRepositoryIntegratedResource) Update(ctx context.Context, req reso
 
 	tflog.Trace(ctx, "updated a check repository integrated resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckRepositoryIntegratedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[CheckRepositoryIntegratedResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -186,5 +206,5 @@ func (r *CheckRepositoryIntegratedResource) Delete(ctx context.Context, req reso
 }
 
 func (r *CheckRepositoryIntegratedResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
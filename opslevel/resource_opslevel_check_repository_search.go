@@ -20,6 +20,8 @@ import (
 var (
 	_ resource.ResourceWithConfigure      = &CheckRepositorySearchResource{}
 	_ resource.ResourceWithImportState    = &CheckRepositorySearchResource{}
+	_ resource.ResourceWithIdentity       = &CheckRepositorySearchResource{}
+	_ resource.ResourceWithModifyPlan     = &CheckRepositorySearchResource{}
 	_ resource.ResourceWithValidateConfig = &CheckRepositorySearchResource{}
 )
 
@@ -29,7 +31,7 @@ func NewCheckRepositorySearchResource() resource.Resource {
 
 // CheckRepositorySearchResource defines the resource implementation.
 type CheckRepositorySearchResource struct {
-	CommonResourceClient
+	CheckResourceClient
 }
 
 type CheckRepositorySearchResourceModel struct {
@@ -119,6 +121,10 @@ func (r *CheckRepositorySearchResource) Schema(ctx context.Context, req resource
 	}
 }
 
+func (r *CheckRepositorySearchResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *CheckRepositorySearchResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	return map[int64]resource.StateUpgrader{
 		// State upgrade implementation from 0 (prior state version) to 1 (Schema.Version)
@@ -193,6 +199,10 @@ func (r *CheckRepositorySearchResource) ValidateConfig(ctx context.Context, req
 }
 
 func (r *CheckRepositorySearchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[CheckRepositorySearchResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -233,6 +243,7 @@ func (r *CheckRepositorySearchResource) Create(ctx context.Context, req resource
 
 	tflog.Trace(ctx, "created a check repository search resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckRepositorySearchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -242,11 +253,10 @@ func (r *CheckRepositorySearchResource) Read(ctx context.Context, req resource.R
 	}
 
 	data, err := r.client.GetCheck(asID(stateModel.Id))
+	if removeIfNotFound(ctx, resp, err, data != nil && data.Id != "") {
+		return
+	}
 	if err != nil {
-		if (data == nil || data.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read check repository search, got error: %s", err))
 		return
 	}
@@ -255,9 +265,14 @@ func (r *CheckRepositorySearchResource) Read(ctx context.Context, req resource.R
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *CheckRepositorySearchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[CheckRepositorySearchResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -300,9 +315,14 @@ func (r *CheckRepositorySearchResource) Update(ctx context.Context, req resource
 
 	tflog.Trace(ctx, "updated a check repository search resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckRepositorySearchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[CheckRepositorySearchResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -317,5 +337,5 @@ func (r *CheckRepositorySearchResource) Delete(ctx context.Context, req resource
 }
 
 func (r *CheckRepositorySearchResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
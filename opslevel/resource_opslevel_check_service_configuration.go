@@ -16,6 +16,8 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = &CheckServiceConfigurationResource{}
 	_ resource.ResourceWithImportState = &CheckServiceConfigurationResource{}
+	_ resource.ResourceWithIdentity    = &CheckServiceConfigurationResource{}
+	_ resource.ResourceWithModifyPlan  = &CheckServiceConfigurationResource{}
 )
 
 func NewCheckServiceConfigurationResource() resource.Resource {
@@ -24,7 +26,7 @@ func NewCheckServiceConfigurationResource() resource.Resource {
 
 // CheckServiceConfigurationResource defines the resource implementation.
 type CheckServiceConfigurationResource struct {
-	CommonResourceClient
+	CheckResourceClient
 }
 
 type CheckServiceConfigurationResourceModel struct {
@@ -79,7 +81,15 @@ func (r *CheckServiceConfigurationResource) Schema(ctx context.Context, req reso
 	}
 }
 
+func (r *CheckServiceConfigurationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *CheckServiceConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[CheckServiceConfigurationResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -112,6 +122,7 @@ func (r *CheckServiceConfigurationResource) Create(ctx context.Context, req reso
 
 	tflog.Trace(ctx, "created a check service configuration resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckServiceConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -121,11 +132,10 @@ func (r *CheckServiceConfigurationResource) Read(ctx context.Context, req resour
 	}
 
 	data, err := r.client.GetCheck(asID(stateModel.Id))
+	if removeIfNotFound(ctx, resp, err, data != nil && data.Id != "") {
+		return
+	}
 	if err != nil {
-		if (data == nil || data.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read check service configuration, got error: %s", err))
 		return
 	}
@@ -133,9 +143,14 @@ func (r *CheckServiceConfigurationResource) Read(ctx context.Context, req resour
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *CheckServiceConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[CheckServiceConfigurationResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -169,9 +184,14 @@ func (r *CheckServiceConfigurationResource) Update(ctx context.Context, req reso
 
 	tflog.Trace(ctx, "updated a check service configuration resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckServiceConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[CheckServiceConfigurationResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -186,5 +206,5 @@ func (r *CheckServiceConfigurationResource) Delete(ctx context.Context, req reso
 }
 
 func (r *CheckServiceConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -16,6 +16,8 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = &CheckServiceDependencyResource{}
 	_ resource.ResourceWithImportState = &CheckServiceDependencyResource{}
+	_ resource.ResourceWithIdentity    = &CheckServiceDependencyResource{}
+	_ resource.ResourceWithModifyPlan  = &CheckServiceDependencyResource{}
 )
 
 func NewCheckServiceDependencyResource() resource.Resource {
@@ -24,7 +26,7 @@ func NewCheckServiceDependencyResource() resource.Resource {
 
 // CheckServiceDependencyResource defines the resource implementation.
 type CheckServiceDependencyResource struct {
-	CommonResourceClient
+	CheckResourceClient
 }
 
 type CheckServiceDependencyResourceModel struct {
@@ -79,7 +81,15 @@ func (r *CheckServiceDependencyResource) Schema(ctx context.Context, req resourc
 	}
 }
 
+func (r *CheckServiceDependencyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *CheckServiceDependencyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[CheckServiceDependencyResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -112,6 +122,7 @@ func (r *CheckServiceDependencyResource) Create(ctx context.Context, req resourc
 
 	tflog.Trace(ctx, "created a check service dependency resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckServiceDependencyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -121,11 +132,10 @@ func (r *CheckServiceDependencyResource) Read(ctx context.Context, req resource.
 	}
 
 	data, err := r.client.GetCheck(asID(stateModel.Id))
+	if removeIfNotFound(ctx, resp, err, data != nil && data.Id != "") {
+		return
+	}
 	if err != nil {
-		if (data == nil || data.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read check service dependency, got error: %s", err))
 		return
 	}
@@ -133,9 +143,14 @@ func (r *CheckServiceDependencyResource) Read(ctx context.Context, req resource.
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *CheckServiceDependencyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[CheckServiceDependencyResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -169,9 +184,14 @@ func (r *CheckServiceDependencyResource) Update(ctx context.Context, req resourc
 
 	tflog.Trace(ctx, "updated a check service dependency resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckServiceDependencyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[CheckServiceDependencyResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -186,5 +206,5 @@ func (r *CheckServiceDependencyResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *CheckServiceDependencyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -23,6 +23,8 @@ import (
 var (
 	_ resource.ResourceWithConfigure      = &CheckServiceOwnershipResource{}
 	_ resource.ResourceWithImportState    = &CheckServiceOwnershipResource{}
+	_ resource.ResourceWithIdentity       = &CheckServiceOwnershipResource{}
+	_ resource.ResourceWithModifyPlan     = &CheckServiceOwnershipResource{}
 	_ resource.ResourceWithUpgradeState   = &CheckServiceOwnershipResource{}
 	_ resource.ResourceWithValidateConfig = &CheckServiceOwnershipResource{}
 )
@@ -33,7 +35,7 @@ func NewCheckServiceOwnershipResource() resource.Resource {
 
 // CheckServiceOwnershipResource defines the resource implementation.
 type CheckServiceOwnershipResource struct {
-	CommonResourceClient
+	CheckResourceClient
 }
 
 type CheckServiceOwnershipResourceModel struct {
@@ -139,6 +141,10 @@ func (r *CheckServiceOwnershipResource) Schema(ctx context.Context, req resource
 	}
 }
 
+func (r *CheckServiceOwnershipResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *CheckServiceOwnershipResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	enumAllContactTypes := append(opslevel.AllContactType, "any")
 	return map[int64]resource.StateUpgrader{
@@ -220,6 +226,10 @@ func (r *CheckServiceOwnershipResource) ValidateConfig(ctx context.Context, req
 }
 
 func (r *CheckServiceOwnershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[CheckServiceOwnershipResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -272,6 +282,7 @@ func (r *CheckServiceOwnershipResource) Create(ctx context.Context, req resource
 
 	tflog.Trace(ctx, "created a check service ownership resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckServiceOwnershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -281,11 +292,10 @@ func (r *CheckServiceOwnershipResource) Read(ctx context.Context, req resource.R
 	}
 
 	data, err := r.client.GetCheck(asID(stateModel.Id))
+	if removeIfNotFound(ctx, resp, err, data != nil && data.Id != "") {
+		return
+	}
 	if err != nil {
-		if (data == nil || data.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read check service ownership, got error: %s", err))
 		return
 	}
@@ -293,9 +303,14 @@ func (r *CheckServiceOwnershipResource) Read(ctx context.Context, req resource.R
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *CheckServiceOwnershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[CheckServiceOwnershipResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -347,9 +362,14 @@ func (r *CheckServiceOwnershipResource) Update(ctx context.Context, req resource
 
 	tflog.Trace(ctx, "updated a check service ownership resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckServiceOwnershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[CheckServiceOwnershipResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -364,5 +384,5 @@ func (r *CheckServiceOwnershipResource) Delete(ctx context.Context, req resource
 }
 
 func (r *CheckServiceOwnershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -21,6 +21,8 @@ import (
 var (
 	_ resource.ResourceWithConfigure      = &CheckServicePropertyResource{}
 	_ resource.ResourceWithImportState    = &CheckServicePropertyResource{}
+	_ resource.ResourceWithIdentity       = &CheckServicePropertyResource{}
+	_ resource.ResourceWithModifyPlan     = &CheckServicePropertyResource{}
 	_ resource.ResourceWithValidateConfig = &CheckServicePropertyResource{}
 )
 
@@ -30,7 +32,7 @@ func NewCheckServicePropertyResource() resource.Resource {
 
 // CheckServicePropertyResource defines the resource implementation.
 type CheckServicePropertyResource struct {
-	CommonResourceClient
+	CheckResourceClient
 }
 
 type CheckServicePropertyResourceModel struct {
@@ -138,6 +140,10 @@ func (r *CheckServicePropertyResource) Schema(ctx context.Context, req resource.
 	}
 }
 
+func (r *CheckServicePropertyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *CheckServicePropertyResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	return map[int64]resource.StateUpgrader{
 		// State upgrade implementation from 0 (prior state version) to 1 (Schema.Version)
@@ -207,6 +213,10 @@ func (r *CheckServicePropertyResource) ValidateConfig(ctx context.Context, req r
 }
 
 func (r *CheckServicePropertyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[CheckServicePropertyResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -262,6 +272,7 @@ func (r *CheckServicePropertyResource) Create(ctx context.Context, req resource.
 
 	tflog.Trace(ctx, "created a check service property resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckServicePropertyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -271,11 +282,10 @@ func (r *CheckServicePropertyResource) Read(ctx context.Context, req resource.Re
 	}
 
 	data, err := r.client.GetCheck(asID(stateModel.Id))
+	if removeIfNotFound(ctx, resp, err, data != nil && data.Id != "") {
+		return
+	}
 	if err != nil {
-		if (data == nil || data.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read check service property, got error: %s", err))
 		return
 	}
@@ -283,9 +293,14 @@ func (r *CheckServicePropertyResource) Read(ctx context.Context, req resource.Re
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *CheckServicePropertyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[CheckServicePropertyResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	stateModel := read[CheckServicePropertyResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
@@ -345,9 +360,14 @@ func (r *CheckServicePropertyResource) Update(ctx context.Context, req resource.
 
 	tflog.Trace(ctx, "updated a check service property resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *CheckServicePropertyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[CheckServicePropertyResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -362,5 +382,5 @@ func (r *CheckServicePropertyResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *CheckServicePropertyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
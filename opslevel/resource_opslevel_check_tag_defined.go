@@ -18,6 +18,8 @@ import (
 var (
 	_ resource.ResourceWithConfigure      = &CheckTagDefinedResource{}
 	_ resource.ResourceWithImportState    = &CheckTagDefinedResource{}
+	_ resource.ResourceWithIdentity       = &CheckTagDefinedResource{}
+	_ resource.ResourceWithModifyPlan     = &CheckTagDefinedResource{}
 	_ resource.ResourceWithValidateConfig = &CheckTagDefinedResource{}
 )
 
@@ -27,7 +29,7 @@ func NewCheckTagDefinedResource() resource.Resource {
 
 // CheckTagDefinedResource defines the resource implementation.
 type CheckTagDefinedResource struct {
-	CommonResourceClient
+	CheckResourceClient
 }
 
 type CheckTagDefinedResourceModel struct {
@@ -105,6 +107,10 @@ func (r *CheckTagDefinedResource) Schema(ctx context.Context, req resource.Schem
 	}
 }
 
+func (r *CheckTagDefinedResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *CheckTagDefinedResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	return map[int64]resource.StateUpgrader{
 		// State upgrade implementation from 0 (prior state version) to 1 (Schema.Version)
@@ -174,6 +180,10 @@ func (r *CheckTagDefinedResource) ValidateConfig(ctx context.Context, req resour
 }
 
 func (r *CheckTagDefinedResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[CheckTagDefinedResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -222,6 +232,7 @@ func (r *CheckTagDefinedResource) Create(ctx context.Context, req resource.Creat
 
 	tflog.Trace(ctx, "created a check tag defined resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckTagDefinedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -231,11 +242,10 @@ func (r *CheckTagDefinedResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	data, err := r.client.GetCheck(asID(stateModel.Id))
+	if removeIfNotFound(ctx, resp, err, data != nil && data.Id != "") {
+		return
+	}
 	if err != nil {
-		if (data == nil || data.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read check tag defined, got error: %s", err))
 		return
 	}
@@ -243,9 +253,14 @@ func (r *CheckTagDefinedResource) Read(ctx context.Context, req resource.ReadReq
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *CheckTagDefinedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[CheckTagDefinedResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -295,9 +310,14 @@ func (r *CheckTagDefinedResource) Update(ctx context.Context, req resource.Updat
 
 	tflog.Trace(ctx, "updated a check tag defined resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckTagDefinedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[CheckTagDefinedResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -312,5 +332,5 @@ func (r *CheckTagDefinedResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *CheckTagDefinedResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -21,6 +21,8 @@ import (
 var (
 	_ resource.ResourceWithConfigure      = &CheckToolUsageResource{}
 	_ resource.ResourceWithImportState    = &CheckToolUsageResource{}
+	_ resource.ResourceWithIdentity       = &CheckToolUsageResource{}
+	_ resource.ResourceWithModifyPlan     = &CheckToolUsageResource{}
 	_ resource.ResourceWithValidateConfig = &CheckToolUsageResource{}
 )
 
@@ -30,7 +32,7 @@ func NewCheckToolUsageResource() resource.Resource {
 
 // CheckToolUsageResource defines the resource implementation.
 type CheckToolUsageResource struct {
-	CommonResourceClient
+	CheckResourceClient
 }
 
 type CheckToolUsageResourceModel struct {
@@ -137,6 +139,10 @@ func (r *CheckToolUsageResource) Schema(ctx context.Context, req resource.Schema
 	}
 }
 
+func (r *CheckToolUsageResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *CheckToolUsageResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	return map[int64]resource.StateUpgrader{
 		// State upgrade implementation from 0 (prior state version) to 1 (Schema.Version)
@@ -241,6 +247,10 @@ func (r *CheckToolUsageResource) ValidateConfig(ctx context.Context, req resourc
 }
 
 func (r *CheckToolUsageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[CheckToolUsageResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -320,6 +330,7 @@ func (r *CheckToolUsageResource) Create(ctx context.Context, req resource.Create
 
 	tflog.Trace(ctx, "created a check tool usage resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckToolUsageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -329,11 +340,10 @@ func (r *CheckToolUsageResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	data, err := r.client.GetCheck(asID(stateModel.Id))
+	if removeIfNotFound(ctx, resp, err, data != nil && data.Id != "") {
+		return
+	}
 	if err != nil {
-		if (data == nil || data.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read check tool usage, got error: %s", err))
 		return
 	}
@@ -341,9 +351,14 @@ func (r *CheckToolUsageResource) Read(ctx context.Context, req resource.ReadRequ
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *CheckToolUsageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[CheckToolUsageResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -428,9 +443,14 @@ func (r *CheckToolUsageResource) Update(ctx context.Context, req resource.Update
 
 	tflog.Trace(ctx, "updated a check tool usage resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *CheckToolUsageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[CheckToolUsageResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -445,5 +465,5 @@ func (r *CheckToolUsageResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *CheckToolUsageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
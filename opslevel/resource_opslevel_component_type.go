@@ -23,6 +23,7 @@ import (
 var (
 	_ resource.Resource                = &ComponentTypeResource{}
 	_ resource.ResourceWithImportState = &ComponentTypeResource{}
+	_ resource.ResourceWithIdentity    = &ComponentTypeResource{}
 )
 
 type PropertyModel struct {
@@ -290,7 +291,15 @@ func (s ComponentTypeResource) Schema(ctx context.Context, req resource.SchemaRe
 	}
 }
 
+func (s ComponentTypeResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (s ComponentTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if s.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[ComponentTypeModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -387,6 +396,7 @@ func (s ComponentTypeResource) Create(ctx context.Context, req resource.CreateRe
 		resp.Diagnostics.AddError("error", fmt.Sprintf("unable to build resource, got error: %s", err))
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, finalModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: finalModel.Id})...)
 }
 
 func (s ComponentTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -397,11 +407,10 @@ func (s ComponentTypeResource) Read(ctx context.Context, req resource.ReadReques
 
 	id := stateModel.Id.ValueString()
 	res, err := s.client.GetComponentType(id)
+	if removeIfNotFound(ctx, resp, err, res != nil && res.Id != "") {
+		return
+	}
 	if err != nil {
-		if (res == nil || res.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("unable to get resource with id '%s', got error: %s", id, err))
 		return
 	}
@@ -445,9 +454,14 @@ func (s ComponentTypeResource) Read(ctx context.Context, req resource.ReadReques
 		resp.Diagnostics.AddError("error", fmt.Sprintf("unable to build resource, got error: %s", err))
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, finalModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: finalModel.Id})...)
 }
 
 func (s ComponentTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if s.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[ComponentTypeModel](ctx, &resp.Diagnostics, req.Plan)
 	stateModel := read[ComponentTypeModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
@@ -522,6 +536,7 @@ func (s ComponentTypeResource) Update(ctx context.Context, req resource.UpdateRe
 		resp.Diagnostics.AddError("error", fmt.Sprintf("unable to build resource, got error: %s", err))
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, finalModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: finalModel.Id})...)
 }
 
 func (s ComponentTypeResource) reconcileRelationships(ctx context.Context, err error, id string, resp *resource.UpdateResponse, planModel ComponentTypeModel) bool {
@@ -593,6 +608,10 @@ func (s ComponentTypeResource) reconcileRelationships(ctx context.Context, err e
 }
 
 func (s ComponentTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if s.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[ComponentTypeModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -608,5 +627,5 @@ func (s ComponentTypeResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (s ComponentTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
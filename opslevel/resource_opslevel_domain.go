@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -18,6 +17,8 @@ var _ resource.ResourceWithConfigure = &DomainResource{}
 
 var _ resource.ResourceWithImportState = &DomainResource{}
 
+var _ resource.ResourceWithIdentity = &DomainResource{}
+
 func NewDomainResource() resource.Resource {
 	return &DomainResource{}
 }
@@ -92,7 +93,15 @@ func (r *DomainResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+func (r *DomainResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[DomainResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -106,12 +115,12 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	teamIdentifier := planModel.Owner.ValueStringPointer()
 	if teamIdentifier != nil && !opslevel.IsID(*teamIdentifier) {
-		team, err := r.client.GetTeamWithAlias(*teamIdentifier)
+		teamId, err := r.cache.resolveTeamID(r.client, *teamIdentifier)
 		if err != nil {
 			resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read team, got error: %s", err))
 			return
 		}
-		*teamIdentifier = string(team.Id)
+		*teamIdentifier = string(teamId)
 	}
 	input.OwnerId = nullableID(teamIdentifier)
 
@@ -124,6 +133,7 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	tflog.Trace(ctx, "created a domain resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &finalModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: finalModel.Id})...)
 }
 
 func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -133,11 +143,10 @@ func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	resource, err := r.client.GetDomain(stateModel.Id.ValueString())
+	if removeIfNotFound(ctx, resp, err, resource != nil && resource.Id != "") {
+		return
+	}
 	if err != nil {
-		if (resource == nil || resource.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read domain, got error: %s", err))
 		return
 	}
@@ -145,9 +154,14 @@ func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &readDomainResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: readDomainResourceModel.Id})...)
 }
 
 func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[DomainResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	stateModel := read[DomainResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
@@ -166,12 +180,12 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 	} else {
 		if !opslevel.IsID(*teamIdentifier) {
-			team, err := r.client.GetTeamWithAlias(*teamIdentifier)
+			teamId, err := r.cache.resolveTeamID(r.client, *teamIdentifier)
 			if err != nil {
 				resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read team, got error: %s", err))
 				return
 			}
-			*teamIdentifier = string(team.Id)
+			*teamIdentifier = string(teamId)
 		}
 		input.OwnerId = nullableID(teamIdentifier)
 	}
@@ -185,9 +199,14 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	tflog.Trace(ctx, "updated a domain resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &finalModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: finalModel.Id})...)
 }
 
 func (r *DomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	data := read[DomainResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -202,5 +221,5 @@ func (r *DomainResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *DomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateWithAlias(ctx, r.client, opslevel.AliasOwnerTypeEnumDomain, req, resp)
 }
@@ -24,6 +24,7 @@ import (
 var (
 	_ resource.ResourceWithConfigure      = &FilterResource{}
 	_ resource.ResourceWithImportState    = &FilterResource{}
+	_ resource.ResourceWithIdentity       = &FilterResource{}
 	_ resource.ResourceWithValidateConfig = &FilterResource{}
 )
 
@@ -231,6 +232,10 @@ func (r *FilterResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+func (r *FilterResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *FilterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var configModel FilterResourceModel
 	var predicateModels []FilterPredicateModel
@@ -252,6 +257,12 @@ func (r *FilterResource) ValidateConfig(ctx context.Context, req resource.Valida
 }
 
 func (r *FilterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	defer r.cache.invalidate(lookupKindFilter)
+
 	var predicateModels []FilterPredicateModel
 
 	planModel := read[FilterResourceModel](ctx, &resp.Diagnostics, req.Plan)
@@ -286,6 +297,7 @@ func (r *FilterResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	tflog.Trace(ctx, "created a filter resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *FilterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -295,11 +307,10 @@ func (r *FilterResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	filter, err := r.client.GetFilter(opslevel.ID(stateModel.Id.ValueString()))
+	if removeIfNotFound(ctx, resp, err, filter != nil && filter.Id != "") {
+		return
+	}
 	if err != nil {
-		if (filter == nil || filter.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read filter, got error: %s", err))
 		return
 	}
@@ -311,9 +322,16 @@ func (r *FilterResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *FilterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	defer r.cache.invalidate(lookupKindFilter)
+
 	planModel := read[FilterResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -354,9 +372,16 @@ func (r *FilterResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	tflog.Trace(ctx, "updated a filter resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *FilterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	defer r.cache.invalidate(lookupKindFilter)
+
 	planModel := read[FilterResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -371,7 +396,7 @@ func (r *FilterResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *FilterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func getConnectiveEnum(connective string) *opslevel.ConnectiveEnum {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,6 +22,8 @@ var _ resource.ResourceWithConfigure = &InfrastructureResource{}
 
 var _ resource.ResourceWithImportState = &InfrastructureResource{}
 
+var _ resource.ResourceWithIdentity = &InfrastructureResource{}
+
 func NewInfrastructureResource() resource.Resource {
 	return &InfrastructureResource{}
 }
@@ -61,6 +64,7 @@ type InfrastructureResourceModel struct {
 	ProviderData *InfraProviderData `tfsdk:"provider_data"`
 	Owner        types.String       `tfsdk:"owner"`
 	Schema       types.String       `tfsdk:"schema"`
+	Timeouts     timeouts.Value     `tfsdk:"timeouts"`
 }
 
 func NewInfrastructureResourceModel(ctx context.Context, infrastructure opslevel.InfrastructureResource, givenModel InfrastructureResourceModel) InfrastructureResourceModel {
@@ -76,6 +80,7 @@ func NewInfrastructureResourceModel(ctx context.Context, infrastructure opslevel
 		ProviderData: providerData,
 		Owner:        RequiredStringValue(string(infrastructure.Owner.Id())),
 		Schema:       RequiredStringValue(infrastructure.Schema),
+		Timeouts:     givenModel.Timeouts,
 	}
 	if givenModel.Aliases.IsNull() {
 		infrastructureResourceModel.Aliases = types.SetNull(types.StringType)
@@ -149,9 +154,16 @@ func (r *InfrastructureResource) Schema(ctx context.Context, req resource.Schema
 				Required:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, reconcileTimeouts),
+		},
 	}
 }
 
+func (r *InfrastructureResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *InfrastructureResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	return map[int64]resource.StateUpgrader{
 		// State upgrade implementation from 0 (prior state version) to 1 (Schema.Version)
@@ -215,7 +227,7 @@ func (r *InfrastructureResource) UpgradeState(ctx context.Context) map[int64]res
 				},
 			},
 			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
-				upgradedStateModel := InfrastructureResourceModel{}
+				upgradedStateModel := InfrastructureResourceModel{Timeouts: nullTimeouts()}
 				infraProviderDataList := types.ListNull(types.ObjectType{AttrTypes: infraProviderDataType})
 
 				resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("aliases"), &upgradedStateModel.Aliases)...)
@@ -242,18 +254,28 @@ func (r *InfrastructureResource) UpgradeState(ctx context.Context) map[int64]res
 }
 
 func (r *InfrastructureResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[InfrastructureResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, client := r.startOperation(ctx, planModel.Timeouts.Create, reconcileTimeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	infraInput, err := newInfraInput(planModel)
 	if err != nil {
 		resp.Diagnostics.AddError("Config error", fmt.Sprintf("Unable to create opslevel InfraInput, got error: %s", err))
 		return
 	}
 
-	infrastructure, err := r.client.CreateInfrastructure(infraInput)
+	infrastructure, err := client.CreateInfrastructure(infraInput)
 	if err != nil {
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to create infrastructure, got error: %s", err))
 		return
@@ -266,11 +288,11 @@ func (r *InfrastructureResource) Create(ctx context.Context, req resource.Create
 			resp.Diagnostics.AddAttributeError(path.Root("aliases"), "Config error", "unable to handle given infrastructure aliases")
 			return
 		}
-		if err = infrastructure.ReconcileAliases(r.client, aliases); err != nil {
+		if err = infrastructure.ReconcileAliases(client, aliases); err != nil {
 			resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to reconcile infrastructure aliases: '%s'\n%s", aliases, err))
 
 			// delete newly created infrastructure to avoid dupliate infrastructure creation on next 'terraform apply'
-			if err := r.client.DeleteInfrastructure(string(infrastructure.Id)); err != nil {
+			if err := client.DeleteInfrastructure(string(infrastructure.Id)); err != nil {
 				resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("failed to delete incorrectly created infrastructure '%s' following aliases error:\n%s", infrastructure.Name, err))
 			}
 		}
@@ -280,6 +302,7 @@ func (r *InfrastructureResource) Create(ctx context.Context, req resource.Create
 
 	tflog.Trace(ctx, "created a infrastructure resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &createdInfrastructureResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: createdInfrastructureResourceModel.Id})...)
 }
 
 func (r *InfrastructureResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -288,12 +311,16 @@ func (r *InfrastructureResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	infrastructure, err := r.client.GetInfrastructure(stateModel.Id.ValueString())
+	ctx, cancel, client := r.startOperation(ctx, stateModel.Timeouts.Read, reconcileTimeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	infrastructure, err := client.GetInfrastructure(stateModel.Id.ValueString())
+	if removeIfNotFound(ctx, resp, err, infrastructure != nil && infrastructure.Id != "") {
+		return
+	}
 	if err != nil {
-		if (infrastructure == nil || infrastructure.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read infrastructure, got error: %s", err))
 		return
 	}
@@ -301,20 +328,31 @@ func (r *InfrastructureResource) Read(ctx context.Context, req resource.ReadRequ
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &readInfrastructureResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: readInfrastructureResourceModel.Id})...)
 }
 
 func (r *InfrastructureResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[InfrastructureResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, client := r.startOperation(ctx, planModel.Timeouts.Update, reconcileTimeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	infraInput, err := newInfraInput(planModel)
 	if err != nil {
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to create opslevel InfraInput, got error: %s", err))
 		return
 	}
-	updatedInfrastructure, err := r.client.UpdateInfrastructure(planModel.Id.ValueString(), infraInput)
+	updatedInfrastructure, err := client.UpdateInfrastructure(planModel.Id.ValueString(), infraInput)
 	if err != nil {
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to update infrastructure, got error: %s", err))
 		return
@@ -326,7 +364,7 @@ func (r *InfrastructureResource) Update(ctx context.Context, req resource.Update
 		resp.Diagnostics.AddAttributeError(path.Root("aliases"), "Config error", "unable to handle given infrastructure aliases")
 		return
 	}
-	if err = updatedInfrastructure.ReconcileAliases(r.client, givenAliases); err != nil {
+	if err = updatedInfrastructure.ReconcileAliases(client, givenAliases); err != nil {
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to reconcile infrastructure aliases: '%s'\n%s", givenAliases, err))
 		return
 	}
@@ -340,15 +378,25 @@ func (r *InfrastructureResource) Update(ctx context.Context, req resource.Update
 
 	tflog.Trace(ctx, "updated a infrastructure resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &updatedInfrastructureResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: updatedInfrastructureResourceModel.Id})...)
 }
 
 func (r *InfrastructureResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[InfrastructureResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	err := r.client.DeleteInfrastructure(stateModel.Id.ValueString())
+	ctx, cancel, client := r.startOperation(ctx, stateModel.Timeouts.Delete, reconcileTimeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := client.DeleteInfrastructure(stateModel.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete infrastructure, got error: %s", err))
 		return
@@ -357,7 +405,7 @@ func (r *InfrastructureResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *InfrastructureResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateWithAlias(ctx, r.client, opslevel.AliasOwnerTypeEnumInfrastructureResource, req, resp)
 }
 
 func newInfraInput(infraModel InfrastructureResourceModel) (opslevel.InfraInput, error) {
@@ -3,7 +3,9 @@ package opslevel
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -23,6 +25,8 @@ var _ resource.ResourceWithConfigure = &IntegrationAwsResource{}
 
 var _ resource.ResourceWithImportState = &IntegrationAwsResource{}
 
+var _ resource.ResourceWithIdentity = &IntegrationAwsResource{}
+
 func NewIntegrationAwsResource() resource.Resource {
 	return &IntegrationAwsResource{}
 }
@@ -34,13 +38,22 @@ type IntegrationAwsResource struct {
 
 // IntegrationAwsResourceModel describes the AWS Integration managed resource.
 type IntegrationAwsResourceModel struct {
-	ExternalID            types.String `tfsdk:"external_id"`
-	IamRole               types.String `tfsdk:"iam_role"`
-	Id                    types.String `tfsdk:"id"`
-	Name                  types.String `tfsdk:"name"`
-	OwnershipTagOverrides types.Bool   `tfsdk:"ownership_tag_overrides"`
-	OwnershipTagKeys      types.List   `tfsdk:"ownership_tag_keys"`
-	RegionOverride        types.List   `tfsdk:"region_override"`
+	ExternalID            types.String   `tfsdk:"external_id"`
+	IamRole               types.String   `tfsdk:"iam_role"`
+	Id                    types.String   `tfsdk:"id"`
+	Name                  types.String   `tfsdk:"name"`
+	OwnershipTagOverrides types.Bool     `tfsdk:"ownership_tag_overrides"`
+	OwnershipTagKeys      types.List     `tfsdk:"ownership_tag_keys"`
+	RegionOverride        types.List     `tfsdk:"region_override"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
+// integrationTimeouts leave time for OpsLevel to validate access to the cloud account
+var integrationTimeouts = operationTimeouts{
+	Create: 10 * time.Minute,
+	Read:   2 * time.Minute,
+	Update: 10 * time.Minute,
+	Delete: 5 * time.Minute,
 }
 
 func NewIntegrationAwsResourceModel(awsIntegration opslevel.Integration) IntegrationAwsResourceModel {
@@ -109,10 +122,21 @@ func (r *IntegrationAwsResource) Schema(ctx context.Context, req resource.Schema
 				Optional:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, integrationTimeouts),
+		},
 	}
 }
 
+func (r *IntegrationAwsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *IntegrationAwsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[IntegrationAwsResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -139,16 +163,28 @@ func (r *IntegrationAwsResource) Create(ctx context.Context, req resource.Create
 		input.RegionOverride = &regionOverride
 	}
 
-	awsIntegration, err := r.client.CreateIntegrationAWS(input)
+	ctx, cancel, client := r.startOperation(ctx, planModel.Timeouts.Create, integrationTimeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	awsIntegration, err := client.CreateIntegrationAWS(input)
 	if err != nil {
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to create aws integration, got error: %s", err))
 		return
 	}
+	if err := waitForIntegration(ctx, client, awsIntegration.Id); err != nil {
+		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("AWS integration '%s' was created but could not be read back, got error: %s", awsIntegration.Id, err))
+		return
+	}
 
 	stateModel := NewIntegrationAwsResourceModel(*awsIntegration)
+	stateModel.Timeouts = planModel.Timeouts
 
 	tflog.Trace(ctx, "created an AWS integration resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *IntegrationAwsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -157,24 +193,34 @@ func (r *IntegrationAwsResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	awsIntegration, err := r.client.GetIntegration(asID(stateModel.Id))
+	ctx, cancel, client := r.startOperation(ctx, stateModel.Timeouts.Read, integrationTimeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	awsIntegration, err := client.GetIntegration(asID(stateModel.Id))
+	if removeIfNotFound(ctx, resp, err, awsIntegration != nil && awsIntegration.Id != "") {
+		return
+	}
 	if err != nil {
-		if (awsIntegration == nil || awsIntegration.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read AWS integration, got error: %s", err))
 		return
 	}
 
 	verifiedStateModel := NewIntegrationAwsResourceModel(*awsIntegration)
+	verifiedStateModel.Timeouts = stateModel.Timeouts
 
 	// Save updated data into Terraform state
 	tflog.Trace(ctx, "read an AWS integration resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *IntegrationAwsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[IntegrationAwsResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	stateModel := read[IntegrationAwsResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
@@ -203,25 +249,41 @@ func (r *IntegrationAwsResource) Update(ctx context.Context, req resource.Update
 		input.RegionOverride = &regionOverride
 	}
 
-	awsIntegration, err := r.client.UpdateIntegrationAWS(planModel.Id.ValueString(), input)
+	ctx, cancel, client := r.startOperation(ctx, planModel.Timeouts.Update, integrationTimeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	awsIntegration, err := client.UpdateIntegrationAWS(planModel.Id.ValueString(), input)
 	if err != nil {
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to update AWS integration, got error: %s", err))
 		return
 	}
 
 	stateModel = NewIntegrationAwsResourceModel(*awsIntegration)
+	stateModel.Timeouts = planModel.Timeouts
 
 	tflog.Trace(ctx, "updated an AWS integration resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *IntegrationAwsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	data := read[IntegrationAwsResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if err := r.client.DeleteIntegration(data.Id.ValueString()); err != nil {
+	ctx, cancel, client := r.startOperation(ctx, data.Timeouts.Delete, integrationTimeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := client.DeleteIntegration(data.Id.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete AWS integration, got error: %s", err))
 		return
 	}
@@ -229,5 +291,5 @@ func (r *IntegrationAwsResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *IntegrationAwsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -27,6 +28,7 @@ const AzureIdRegexPattern = `^[0-9A-Fa-f]{8}-([0-9A-Fa-f]{4}-){3}[0-9A-Fa-f]{12}
 var (
 	_ resource.ResourceWithConfigure   = &IntegrationAzureResourcesResource{}
 	_ resource.ResourceWithImportState = &IntegrationAzureResourcesResource{}
+	_ resource.ResourceWithIdentity    = &IntegrationAzureResourcesResource{}
 )
 
 func NewIntegrationAzureResourcesResource() resource.Resource {
@@ -41,6 +43,8 @@ type IntegrationAzureResourcesResourceModel struct {
 	Aliases               types.List   `tfsdk:"aliases"`
 	ClientId              types.String `tfsdk:"client_id"`
 	ClientSecret          types.String `tfsdk:"client_secret"`
+	ClientSecretWo        types.String `tfsdk:"client_secret_wo"`
+	ClientSecretWoVersion types.Int64  `tfsdk:"client_secret_wo_version"`
 	CreatedAt             types.String `tfsdk:"created_at"`
 	Id                    types.String `tfsdk:"id"`
 	InstalledAt           types.String `tfsdk:"installed_at"`
@@ -53,16 +57,18 @@ type IntegrationAzureResourcesResourceModel struct {
 
 func NewIntegrationAzureResourcesResourceModel(ctx context.Context, azureResourcesIntegration opslevel.Integration, givenModel IntegrationAzureResourcesResourceModel) IntegrationAzureResourcesResourceModel {
 	resourceModel := IntegrationAzureResourcesResourceModel{
-		Aliases:          OptionalStringListValue(azureResourcesIntegration.AzureResourcesIntegrationFragment.Aliases),
-		ClientId:         givenModel.ClientId,
-		ClientSecret:     givenModel.ClientSecret,
-		CreatedAt:        ComputedStringValue(azureResourcesIntegration.CreatedAt.Local().Format(time.RFC850)),
-		Id:               ComputedStringValue(string(azureResourcesIntegration.Id)),
-		InstalledAt:      ComputedStringValue(azureResourcesIntegration.InstalledAt.Local().Format(time.RFC850)),
-		Name:             RequiredStringValue(azureResourcesIntegration.Name),
-		OwnershipTagKeys: OptionalStringListValue(azureResourcesIntegration.AzureResourcesIntegrationFragment.OwnershipTagKeys),
-		SubscriptionId:   RequiredStringValue(azureResourcesIntegration.SubscriptionId),
-		TenantId:         RequiredStringValue(azureResourcesIntegration.TenantId),
+		Aliases:               OptionalStringListValue(azureResourcesIntegration.AzureResourcesIntegrationFragment.Aliases),
+		ClientId:              givenModel.ClientId,
+		ClientSecret:          givenModel.ClientSecret,
+		ClientSecretWo:        types.StringNull(),
+		ClientSecretWoVersion: givenModel.ClientSecretWoVersion,
+		CreatedAt:             ComputedStringValue(azureResourcesIntegration.CreatedAt.Local().Format(time.RFC850)),
+		Id:                    ComputedStringValue(string(azureResourcesIntegration.Id)),
+		InstalledAt:           ComputedStringValue(azureResourcesIntegration.InstalledAt.Local().Format(time.RFC850)),
+		Name:                  RequiredStringValue(azureResourcesIntegration.Name),
+		OwnershipTagKeys:      OptionalStringListValue(azureResourcesIntegration.AzureResourcesIntegrationFragment.OwnershipTagKeys),
+		SubscriptionId:        RequiredStringValue(azureResourcesIntegration.SubscriptionId),
+		TenantId:              RequiredStringValue(azureResourcesIntegration.TenantId),
 	}
 	if givenModel.TagsOverrideOwnership.IsNull() {
 		resourceModel.TagsOverrideOwnership = types.BoolNull()
@@ -92,9 +98,28 @@ func (r *IntegrationAzureResourcesResource) Schema(ctx context.Context, req reso
 				Required:    true,
 			},
 			"client_secret": schema.StringAttribute{
-				Description: "The client secret OpsLevel uses to access the Azure account.",
-				Required:    true,
+				Description: "The client secret OpsLevel uses to access the Azure account. Conflicts with `client_secret_wo`.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("client_secret"), path.MatchRoot("client_secret_wo")),
+				},
+			},
+			"client_secret_wo": schema.StringAttribute{
+				Description: "The client secret OpsLevel uses to access the Azure account, never stored in the plan or state. Requires Terraform 1.11 or later. Conflicts with `client_secret`.",
+				Optional:    true,
 				Sensitive:   true,
+				WriteOnly:   true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_secret_wo_version")),
+				},
+			},
+			"client_secret_wo_version": schema.Int64Attribute{
+				Description: "The version of `client_secret_wo`. Terraform cannot detect changes to write-only values, so increment this to send a new `client_secret_wo` to OpsLevel.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("client_secret_wo")),
+				},
 			},
 			"created_at": schema.StringAttribute{
 				Description: "The time this integration was created.",
@@ -164,7 +189,15 @@ func (r *IntegrationAzureResourcesResource) Schema(ctx context.Context, req reso
 	}
 }
 
+func (r *IntegrationAzureResourcesResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *IntegrationAzureResourcesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[IntegrationAzureResourcesResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -176,9 +209,17 @@ func (r *IntegrationAzureResourcesResource) Create(ctx context.Context, req reso
 		return
 	}
 
+	clientSecret := planModel.ClientSecret
+	if clientSecret.IsNull() {
+		clientSecret = writeOnlyStringValue(ctx, &resp.Diagnostics, req.Config, "client_secret_wo")
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	input := opslevel.AzureResourcesIntegrationInput{
 		ClientId:              nullable(planModel.ClientId.ValueStringPointer()),
-		ClientSecret:          nullable(planModel.ClientSecret.ValueStringPointer()),
+		ClientSecret:          nullable(clientSecret.ValueStringPointer()),
 		Name:                  nullable(planModel.Name.ValueStringPointer()),
 		OwnershipTagKeys:      &opslevel.Nullable[[]string]{Value: ownershipTagKeys}, // TODO: why does this need to be nullable?
 		SubscriptionId:        nullable(planModel.SubscriptionId.ValueStringPointer()),
@@ -196,6 +237,7 @@ func (r *IntegrationAzureResourcesResource) Create(ctx context.Context, req reso
 
 	tflog.Trace(ctx, "created an Azure Resources integration")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *IntegrationAzureResourcesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -205,11 +247,10 @@ func (r *IntegrationAzureResourcesResource) Read(ctx context.Context, req resour
 	}
 
 	azureResourcesIntegration, err := r.client.GetIntegration(asID(stateModel.Id))
+	if removeIfNotFound(ctx, resp, err, azureResourcesIntegration != nil && azureResourcesIntegration.Id != "") {
+		return
+	}
 	if err != nil {
-		if (azureResourcesIntegration == nil || azureResourcesIntegration.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read Azure Resources integration, got error: '%s'", err))
 		return
 	}
@@ -219,10 +260,16 @@ func (r *IntegrationAzureResourcesResource) Read(ctx context.Context, req resour
 	// Save updated data into Terraform state
 	tflog.Trace(ctx, "read an Azure Resources integration")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *IntegrationAzureResourcesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[IntegrationAzureResourcesResourceModel](ctx, &resp.Diagnostics, req.Plan)
+	stateModel := read[IntegrationAzureResourcesResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -232,9 +279,22 @@ func (r *IntegrationAzureResourcesResource) Update(ctx context.Context, req reso
 		return
 	}
 
+	var clientSecret *opslevel.Nullable[string]
+	switch {
+	case !planModel.ClientSecret.IsNull():
+		clientSecret = nullable(planModel.ClientSecret.ValueStringPointer())
+	case !planModel.ClientSecretWoVersion.Equal(stateModel.ClientSecretWoVersion):
+		// write-only values are only rotated when their version changes
+		clientSecretWo := writeOnlyStringValue(ctx, &resp.Diagnostics, req.Config, "client_secret_wo")
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		clientSecret = nullable(clientSecretWo.ValueStringPointer())
+	}
+
 	input := opslevel.AzureResourcesIntegrationInput{
 		ClientId:              nullable(planModel.ClientId.ValueStringPointer()),
-		ClientSecret:          nullable(planModel.ClientSecret.ValueStringPointer()),
+		ClientSecret:          clientSecret,
 		Name:                  nullable(planModel.Name.ValueStringPointer()),
 		OwnershipTagKeys:      &opslevel.Nullable[[]string]{Value: ownershipTagKeys}, // TODO: why does this need to be nullable?
 		SubscriptionId:        nullable(planModel.SubscriptionId.ValueStringPointer()),
@@ -248,13 +308,18 @@ func (r *IntegrationAzureResourcesResource) Update(ctx context.Context, req reso
 		return
 	}
 
-	stateModel := NewIntegrationAzureResourcesResourceModel(ctx, *azureResourcesIntegration, planModel)
+	stateModel = NewIntegrationAzureResourcesResourceModel(ctx, *azureResourcesIntegration, planModel)
 
 	tflog.Trace(ctx, "updated an Azure Resources integration")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *IntegrationAzureResourcesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	data := read[IntegrationAzureResourcesResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -268,5 +333,5 @@ func (r *IntegrationAzureResourcesResource) Delete(ctx context.Context, req reso
 }
 
 func (r *IntegrationAzureResourcesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -21,6 +21,8 @@ var _ resource.ResourceWithConfigure = &IntegrationEndpointResource{}
 
 var _ resource.ResourceWithImportState = &IntegrationEndpointResource{}
 
+var _ resource.ResourceWithIdentity = &IntegrationEndpointResource{}
+
 func NewIntegrationEndpointResource() resource.Resource {
 	return &IntegrationEndpointResource{}
 }
@@ -89,7 +91,15 @@ func (r *IntegrationEndpointResource) Schema(ctx context.Context, req resource.S
 	}
 }
 
+func (r *IntegrationEndpointResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *IntegrationEndpointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[IntegrationEndpointResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -110,6 +120,7 @@ func (r *IntegrationEndpointResource) Create(ctx context.Context, req resource.C
 
 	tflog.Trace(ctx, "created a Integration Endpoint resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *IntegrationEndpointResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -119,11 +130,10 @@ func (r *IntegrationEndpointResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	integrationEndpoint, err := r.client.GetIntegration(opslevel.ID(stateModel.Id.ValueString()))
+	if removeIfNotFound(ctx, resp, err, integrationEndpoint != nil && integrationEndpoint.Id != "") {
+		return
+	}
 	if err != nil {
-		if (integrationEndpoint == nil || integrationEndpoint.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read Integration Endpoint, got error: %s", err))
 		return
 	}
@@ -133,9 +143,14 @@ func (r *IntegrationEndpointResource) Read(ctx context.Context, req resource.Rea
 	// Save updated data into Terraform state
 	tflog.Trace(ctx, "read a Integration Endpoint resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *IntegrationEndpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[IntegrationEndpointResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -156,9 +171,14 @@ func (r *IntegrationEndpointResource) Update(ctx context.Context, req resource.U
 
 	tflog.Trace(ctx, "updated a Integration Endpoint resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *IntegrationEndpointResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[IntegrationEndpointResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -172,5 +192,5 @@ func (r *IntegrationEndpointResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *IntegrationEndpointResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -24,6 +27,7 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = &integrationGoogleCloudResource{}
 	_ resource.ResourceWithImportState = &integrationGoogleCloudResource{}
+	_ resource.ResourceWithIdentity    = &integrationGoogleCloudResource{}
 )
 
 func NewIntegrationGoogleCloudResource() resource.Resource {
@@ -49,16 +53,19 @@ func googleCloudProjectAttrs() map[string]attr.Type {
 }
 
 type integrationGoogleCloudResourceModel struct {
-	Aliases               types.List   `tfsdk:"aliases"`
-	ClientEmail           types.String `tfsdk:"client_email"`
-	CreatedAt             types.String `tfsdk:"created_at"`
-	Id                    types.String `tfsdk:"id"`
-	InstalledAt           types.String `tfsdk:"installed_at"`
-	Name                  types.String `tfsdk:"name"`
-	OwnershipTagKeys      types.List   `tfsdk:"ownership_tag_keys"`
-	PrivateKey            types.String `tfsdk:"private_key"`
-	Projects              types.List   `tfsdk:"projects"`
-	TagsOverrideOwnership types.Bool   `tfsdk:"ownership_tag_overrides"`
+	Aliases               types.List     `tfsdk:"aliases"`
+	ClientEmail           types.String   `tfsdk:"client_email"`
+	CreatedAt             types.String   `tfsdk:"created_at"`
+	Id                    types.String   `tfsdk:"id"`
+	InstalledAt           types.String   `tfsdk:"installed_at"`
+	Name                  types.String   `tfsdk:"name"`
+	OwnershipTagKeys      types.List     `tfsdk:"ownership_tag_keys"`
+	PrivateKey            types.String   `tfsdk:"private_key"`
+	PrivateKeyWo          types.String   `tfsdk:"private_key_wo"`
+	PrivateKeyWoVersion   types.Int64    `tfsdk:"private_key_wo_version"`
+	Projects              types.List     `tfsdk:"projects"`
+	TagsOverrideOwnership types.Bool     `tfsdk:"ownership_tag_overrides"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
 }
 
 func newIntegrationGoogleCloudResourceModel(ctx context.Context, googleCloudIntegration opslevel.Integration, givenModel integrationGoogleCloudResourceModel, diags *diag.Diagnostics) integrationGoogleCloudResourceModel {
@@ -70,7 +77,10 @@ func newIntegrationGoogleCloudResourceModel(ctx context.Context, googleCloudInte
 		InstalledAt:           ComputedStringValue(googleCloudIntegration.InstalledAt.UTC().Format(time.RFC3339)),
 		Name:                  RequiredStringValue(googleCloudIntegration.Name),
 		PrivateKey:            givenModel.PrivateKey,
+		PrivateKeyWo:          types.StringNull(),
+		PrivateKeyWoVersion:   givenModel.PrivateKeyWoVersion,
 		TagsOverrideOwnership: types.BoolValue(googleCloudIntegration.GoogleCloudIntegrationFragment.TagsOverrideOwnership),
+		Timeouts:              givenModel.Timeouts,
 	}
 
 	if len(googleCloudIntegration.GoogleCloudIntegrationFragment.OwnershipTagKeys) == 0 {
@@ -157,9 +167,28 @@ func (r *integrationGoogleCloudResource) Schema(ctx context.Context, req resourc
 				Default: booldefault.StaticBool(true),
 			},
 			"private_key": schema.StringAttribute{
-				Description: "The private key for the service account that OpsLevel uses to access the Google Cloud account.",
-				Required:    true,
+				Description: "The private key for the service account that OpsLevel uses to access the Google Cloud account. Conflicts with `private_key_wo`.",
+				Optional:    true,
 				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("private_key"), path.MatchRoot("private_key_wo")),
+				},
+			},
+			"private_key_wo": schema.StringAttribute{
+				Description: "The private key for the service account that OpsLevel uses to access the Google Cloud account, never stored in the plan or state. Requires Terraform 1.11 or later. Conflicts with `private_key`.",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("private_key_wo_version")),
+				},
+			},
+			"private_key_wo_version": schema.Int64Attribute{
+				Description: "The version of `private_key_wo`. Terraform cannot detect changes to write-only values, so increment this to send a new `private_key_wo` to OpsLevel.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("private_key_wo")),
+				},
 			},
 			"projects": schema.ListAttribute{
 				Description: "A list of the Google Cloud projects that were imported by the integration.",
@@ -169,10 +198,21 @@ func (r *integrationGoogleCloudResource) Schema(ctx context.Context, req resourc
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, integrationTimeouts),
+		},
 	}
 }
 
+func (r *integrationGoogleCloudResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *integrationGoogleCloudResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[integrationGoogleCloudResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -184,19 +224,37 @@ func (r *integrationGoogleCloudResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	privateKey := planModel.PrivateKey
+	if privateKey.IsNull() {
+		privateKey = writeOnlyStringValue(ctx, &resp.Diagnostics, req.Config, "private_key_wo")
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	input := opslevel.GoogleCloudIntegrationInput{
 		ClientEmail:           nullable(planModel.ClientEmail.ValueStringPointer()),
 		Name:                  nullable(planModel.Name.ValueStringPointer()),
-		PrivateKey:            nullable(planModel.PrivateKey.ValueStringPointer()),
+		PrivateKey:            nullable(privateKey.ValueStringPointer()),
 		OwnershipTagKeys:      &opslevel.Nullable[[]string]{Value: ownershipTagKeys},
 		TagsOverrideOwnership: nullable(planModel.TagsOverrideOwnership.ValueBoolPointer()),
 	}
 
-	createdIntegration, err := r.client.CreateIntegrationGCP(input)
+	ctx, cancel, client := r.startOperation(ctx, planModel.Timeouts.Create, integrationTimeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdIntegration, err := client.CreateIntegrationGCP(input)
 	if err != nil {
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to create Google Cloud integration, got error: '%s'", err))
 		return
 	}
+	if err := waitForIntegration(ctx, client, createdIntegration.Id); err != nil {
+		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Google Cloud integration '%s' was created but could not be read back, got error: '%s'", createdIntegration.Id, err))
+		return
+	}
 
 	stateModel := newIntegrationGoogleCloudResourceModel(ctx, *createdIntegration, planModel, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -205,6 +263,7 @@ func (r *integrationGoogleCloudResource) Create(ctx context.Context, req resourc
 
 	tflog.Trace(ctx, "created a Google Cloud integration")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *integrationGoogleCloudResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -213,12 +272,16 @@ func (r *integrationGoogleCloudResource) Read(ctx context.Context, req resource.
 		return
 	}
 
-	readIntegration, err := r.client.GetIntegration(asID(stateModel.Id))
+	ctx, cancel, client := r.startOperation(ctx, stateModel.Timeouts.Read, integrationTimeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	readIntegration, err := client.GetIntegration(asID(stateModel.Id))
+	if removeIfNotFound(ctx, resp, err, readIntegration != nil && readIntegration.Id != "") {
+		return
+	}
 	if err != nil {
-		if (readIntegration == nil || readIntegration.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read Google Cloud integration, got error: '%s'", err))
 		return
 	}
@@ -230,10 +293,16 @@ func (r *integrationGoogleCloudResource) Read(ctx context.Context, req resource.
 
 	tflog.Trace(ctx, "read a Google Cloud integration")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *integrationGoogleCloudResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[integrationGoogleCloudResourceModel](ctx, &resp.Diagnostics, req.Plan)
+	stateModel := read[integrationGoogleCloudResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -244,36 +313,64 @@ func (r *integrationGoogleCloudResource) Update(ctx context.Context, req resourc
 		return
 	}
 
+	var privateKey *opslevel.Nullable[string]
+	switch {
+	case !planModel.PrivateKey.IsNull():
+		privateKey = nullable(planModel.PrivateKey.ValueStringPointer())
+	case !planModel.PrivateKeyWoVersion.Equal(stateModel.PrivateKeyWoVersion):
+		// write-only values are only rotated when their version changes
+		privateKeyWo := writeOnlyStringValue(ctx, &resp.Diagnostics, req.Config, "private_key_wo")
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		privateKey = nullable(privateKeyWo.ValueStringPointer())
+	}
+
 	input := opslevel.GoogleCloudIntegrationInput{
 		ClientEmail:           nullable(planModel.ClientEmail.ValueStringPointer()),
 		Name:                  nullable(planModel.Name.ValueStringPointer()),
 		OwnershipTagKeys:      &opslevel.Nullable[[]string]{Value: ownershipTagKeys}, // TODO: why does this need to be nullable?
-		PrivateKey:            nullable(planModel.PrivateKey.ValueStringPointer()),
+		PrivateKey:            privateKey,
 		TagsOverrideOwnership: nullable(planModel.TagsOverrideOwnership.ValueBoolPointer()),
 	}
 
-	updatedIntegration, err := r.client.UpdateIntegrationGCP(planModel.Id.ValueString(), input)
+	ctx, cancel, client := r.startOperation(ctx, planModel.Timeouts.Update, integrationTimeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updatedIntegration, err := client.UpdateIntegrationGCP(planModel.Id.ValueString(), input)
 	if err != nil {
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to update Google Cloud integration, got error: '%s'", err))
 		return
 	}
 
-	stateModel := newIntegrationGoogleCloudResourceModel(ctx, *updatedIntegration, planModel, &resp.Diagnostics)
+	stateModel = newIntegrationGoogleCloudResourceModel(ctx, *updatedIntegration, planModel, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	tflog.Trace(ctx, "updated a Google Cloud integration")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *integrationGoogleCloudResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	data := read[integrationGoogleCloudResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if err := r.client.DeleteIntegration(data.Id.ValueString()); err != nil {
+	ctx, cancel, client := r.startOperation(ctx, data.Timeouts.Delete, integrationTimeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := client.DeleteIntegration(data.Id.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Google Cloud integration, got error: '%s'", err))
 		return
 	}
@@ -281,5 +378,5 @@ func (r *integrationGoogleCloudResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *integrationGoogleCloudResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
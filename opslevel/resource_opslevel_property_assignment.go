@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -19,6 +20,7 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = &PropertyAssignmentResource{}
 	_ resource.ResourceWithImportState = &PropertyAssignmentResource{}
+	_ resource.ResourceWithIdentity    = &PropertyAssignmentResource{}
 )
 
 type PropertyAssignmentResource struct {
@@ -37,6 +39,19 @@ type PropertyAssignmentResourceModel struct {
 	Value      types.String `tfsdk:"value"`
 }
 
+// propertyAssignmentIdentityModel identifies a property assignment by its owner and property definition
+type propertyAssignmentIdentityModel struct {
+	Definition types.String `tfsdk:"definition"`
+	Owner      types.String `tfsdk:"owner"`
+}
+
+func (m PropertyAssignmentResourceModel) identity() propertyAssignmentIdentityModel {
+	return propertyAssignmentIdentityModel{
+		Definition: m.Definition,
+		Owner:      m.Owner,
+	}
+}
+
 func NewPropertyAssignmentResourceModel(assignment opslevel.Property) PropertyAssignmentResourceModel {
 	model := PropertyAssignmentResourceModel{
 		Locked: types.BoolValue(assignment.Locked),
@@ -100,7 +115,26 @@ func (resource *PropertyAssignmentResource) Schema(ctx context.Context, req reso
 	}
 }
 
+func (resource *PropertyAssignmentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"definition": identityschema.StringAttribute{
+				Description:       "The id or alias of the property definition.",
+				RequiredForImport: true,
+			},
+			"owner": identityschema.StringAttribute{
+				Description:       "The id or alias of the entity the property is assigned to.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (resource *PropertyAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if resource.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[PropertyAssignmentResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -131,6 +165,7 @@ func (resource *PropertyAssignmentResource) Create(ctx context.Context, req reso
 
 	tflog.Trace(ctx, fmt.Sprintf("assigned property (%s) on service (%s) with value: '%s'", definition, owner, input.Value))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, stateModel.identity())...)
 }
 
 func (resource *PropertyAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -142,12 +177,11 @@ func (resource *PropertyAssignmentResource) Read(ctx context.Context, req resour
 	definition := stateModel.Definition.ValueString()
 	owner := stateModel.Owner.ValueString()
 	assignment, err := resource.client.GetProperty(owner, definition)
-	if err != nil {
-		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("unable to read property assignment '%s' on service '%s', got error: %s", definition, owner, err))
+	if removeIfNotFound(ctx, resp, err, assignment != nil) {
 		return
 	}
-	if assignment == nil {
-		resp.State.RemoveResource(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("unable to read property assignment '%s' on service '%s', got error: %s", definition, owner, err))
 		return
 	}
 
@@ -158,13 +192,22 @@ func (resource *PropertyAssignmentResource) Read(ctx context.Context, req resour
 
 	tflog.Trace(ctx, fmt.Sprintf("read property assignment (%s) on service (%s) with value: '%v'", definition, owner, assignment.Value))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, verifiedStateModel.identity())...)
 }
 
 func (resource *PropertyAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if resource.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	resp.Diagnostics.AddError("terraform plugin error", "property assignments should never be updated, only replaced.\nplease file a bug report including your .tf file at: github.com/OpsLevel/terraform-provider-opslevel")
 }
 
 func (resource *PropertyAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if resource.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	planModel := read[PropertyAssignmentResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -181,11 +224,20 @@ func (resource *PropertyAssignmentResource) Delete(ctx context.Context, req reso
 }
 
 func (r *PropertyAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	ids := strings.Split(req.ID, ":")
+	importID := req.ID
+	if importID == "" {
+		identity := read[propertyAssignmentIdentityModel](ctx, &resp.Diagnostics, req.Identity)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		importID = identity.Owner.ValueString() + ":" + identity.Definition.ValueString()
+	}
+
+	ids := strings.Split(importID, ":")
 	if len(ids) != 2 {
 		resp.Diagnostics.AddError(
 			"Invalid format given for Import Id",
-			fmt.Sprintf("Id expected to be formatted as '<service-id-or-alias>:<property-id-or-alias>'. Given '%s'", req.ID),
+			fmt.Sprintf("Id expected to be formatted as '<service-id-or-alias>:<property-id-or-alias>'. Given '%s'", importID),
 		)
 		return
 	}
@@ -20,6 +20,7 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = &PropertyDefinitionResource{}
 	_ resource.ResourceWithImportState = &PropertyDefinitionResource{}
+	_ resource.ResourceWithIdentity    = &PropertyDefinitionResource{}
 )
 
 type PropertyDefinitionResource struct {
@@ -113,7 +114,15 @@ func (resource *PropertyDefinitionResource) Schema(ctx context.Context, req reso
 	}
 }
 
+func (resource *PropertyDefinitionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (resource *PropertyDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if resource.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[PropertyDefinitionResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -142,6 +151,7 @@ func (resource *PropertyDefinitionResource) Create(ctx context.Context, req reso
 	stateModel := NewPropertyDefinitionResourceModel(*definition, planModel)
 	tflog.Trace(ctx, fmt.Sprintf("created a definition resource with id '%s'", definition.Id))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (resource *PropertyDefinitionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -152,11 +162,10 @@ func (resource *PropertyDefinitionResource) Read(ctx context.Context, req resour
 
 	id := stateModel.Id.ValueString()
 	definition, err := resource.client.GetPropertyDefinition(id)
+	if removeIfNotFound(ctx, resp, err, definition != nil && definition.Id != "") {
+		return
+	}
 	if err != nil {
-		if (definition == nil || definition.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("unable to read definition with id '%s', got error: %s", id, err))
 		return
 	}
@@ -164,9 +173,14 @@ func (resource *PropertyDefinitionResource) Read(ctx context.Context, req resour
 	verifiedStateModel := NewPropertyDefinitionResourceModel(*definition, stateModel)
 	tflog.Trace(ctx, fmt.Sprintf("read a definition resource with id '%s'", id))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (resource *PropertyDefinitionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if resource.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[PropertyDefinitionResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -196,9 +210,14 @@ func (resource *PropertyDefinitionResource) Update(ctx context.Context, req reso
 	stateModel := NewPropertyDefinitionResourceModel(*definition, planModel)
 	tflog.Trace(ctx, fmt.Sprintf("updated a definition resource with id '%s'", id))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (resource *PropertyDefinitionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if resource.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[PropertyDefinitionResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -214,5 +233,5 @@ func (resource *PropertyDefinitionResource) Delete(ctx context.Context, req reso
 }
 
 func (r *PropertyDefinitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -20,6 +20,7 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = &RelationshipAssignmentResource{}
 	_ resource.ResourceWithImportState = &RelationshipAssignmentResource{}
+	_ resource.ResourceWithIdentity    = &RelationshipAssignmentResource{}
 )
 
 func NewRelationshipAssignmentResource() resource.Resource {
@@ -162,7 +163,15 @@ func (r *RelationshipAssignmentResource) Schema(ctx context.Context, req resourc
 	}
 }
 
+func (r *RelationshipAssignmentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *RelationshipAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[RelationshipAssignmentResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -192,6 +201,7 @@ func (r *RelationshipAssignmentResource) Create(ctx context.Context, req resourc
 	stateModel := NewRelationshipAssignmentResourceModel(relationship, planModel)
 	tflog.Trace(ctx, fmt.Sprintf("created a relationship from '%s' to '%s'", source, target))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *RelationshipAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -201,28 +211,32 @@ func (r *RelationshipAssignmentResource) Read(ctx context.Context, req resource.
 	}
 
 	obj, err := r.client.GetRelationship(stateModel.Id.ValueString())
-	if err != nil {
-		if strings.Contains(err.Error(), "is not a valid ID") {
-			resp.State.RemoveResource(ctx)
-			return
-		}
-		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("unable to read relationship assignment, got error: %s", err))
+	if removeIfNotFound(ctx, resp, err, obj != nil) {
 		return
 	}
-	if obj == nil {
-		resp.State.RemoveResource(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("unable to read relationship assignment, got error: %s", err))
 		return
 	}
 
 	verifiedStateModel := NewRelationshipAssignmentResourceModel(obj, stateModel)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *RelationshipAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	resp.Diagnostics.AddError("terraform plugin error", "relationship assignments cannot be updated, only replaced.\nplease file a bug report including your .tf file at: github.com/OpsLevel/terraform-provider-opslevel")
 }
 
 func (r *RelationshipAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[RelationshipAssignmentResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -242,5 +256,5 @@ func (r *RelationshipAssignmentResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *RelationshipAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
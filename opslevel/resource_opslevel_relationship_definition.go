@@ -20,6 +20,7 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = &RelationshipDefinitionResource{}
 	_ resource.ResourceWithImportState = &RelationshipDefinitionResource{}
+	_ resource.ResourceWithIdentity    = &RelationshipDefinitionResource{}
 )
 
 func NewRelationshipDefinitionResource() resource.Resource {
@@ -127,7 +128,15 @@ func (r *RelationshipDefinitionResource) Schema(ctx context.Context, req resourc
 	}
 }
 
+func (r *RelationshipDefinitionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *RelationshipDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[RelationshipDefinitionResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -176,6 +185,7 @@ func (r *RelationshipDefinitionResource) Create(ctx context.Context, req resourc
 	stateModel := NewRelationshipDefinitionResourceModel(*definition, planModel)
 	tflog.Trace(ctx, fmt.Sprintf("created a relationship definition resource with id '%s'", definition.Id))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *RelationshipDefinitionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -186,11 +196,10 @@ func (r *RelationshipDefinitionResource) Read(ctx context.Context, req resource.
 
 	id := stateModel.Id.ValueString()
 	definition, err := r.client.GetRelationshipDefinition(id)
+	if removeIfNotFound(ctx, resp, err, definition != nil && definition.Id != "") {
+		return
+	}
 	if err != nil {
-		if (definition == nil || definition.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("unable to read relationship definition with id '%s', got error: %s", id, err))
 		return
 	}
@@ -198,9 +207,14 @@ func (r *RelationshipDefinitionResource) Read(ctx context.Context, req resource.
 	verifiedStateModel := NewRelationshipDefinitionResourceModel(*definition, stateModel)
 	tflog.Trace(ctx, fmt.Sprintf("read a relationship definition resource with id '%s'", id))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *RelationshipDefinitionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[RelationshipDefinitionResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -250,9 +264,14 @@ func (r *RelationshipDefinitionResource) Update(ctx context.Context, req resourc
 	stateModel := NewRelationshipDefinitionResourceModel(*definition, planModel)
 	tflog.Trace(ctx, fmt.Sprintf("updated a relationship definition resource with id '%s'", id))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *RelationshipDefinitionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[RelationshipDefinitionResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -268,7 +287,7 @@ func (r *RelationshipDefinitionResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *RelationshipDefinitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *RelationshipDefinitionResource) GetComponentTypeAlias(componentTypeValue string, diags *diag.Diagnostics) string {
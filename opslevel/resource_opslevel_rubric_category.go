@@ -18,6 +18,8 @@ var _ resource.ResourceWithConfigure = &RubricCategoryResource{}
 
 var _ resource.ResourceWithImportState = &RubricCategoryResource{}
 
+var _ resource.ResourceWithIdentity = &RubricCategoryResource{}
+
 func NewRubricCategoryResource() resource.Resource {
 	return &RubricCategoryResource{}
 }
@@ -65,7 +67,17 @@ func (r *RubricCategoryResource) Schema(ctx context.Context, req resource.Schema
 	}
 }
 
+func (r *RubricCategoryResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *RubricCategoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	defer r.cache.invalidate(lookupKindCategory, lookupKindScorecardCategory)
+
 	data := read[RubricCategoryResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -83,6 +95,7 @@ func (r *RubricCategoryResource) Create(ctx context.Context, req resource.Create
 
 	tflog.Trace(ctx, "created a rubric category resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &createdRubricCategoryResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: createdRubricCategoryResourceModel.Id})...)
 }
 
 func (r *RubricCategoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -92,11 +105,10 @@ func (r *RubricCategoryResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	rubricCategory, err := r.client.GetCategory(asID(data.Id))
+	if removeIfNotFound(ctx, resp, err, rubricCategory != nil && rubricCategory.Id != "") {
+		return
+	}
 	if err != nil {
-		if (rubricCategory == nil || rubricCategory.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read rubric category, got error: %s", err))
 		return
 	}
@@ -104,9 +116,16 @@ func (r *RubricCategoryResource) Read(ctx context.Context, req resource.ReadRequ
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &readRubricCategoryResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: readRubricCategoryResourceModel.Id})...)
 }
 
 func (r *RubricCategoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	defer r.cache.invalidate(lookupKindCategory, lookupKindScorecardCategory)
+
 	data := read[RubricCategoryResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -124,9 +143,16 @@ func (r *RubricCategoryResource) Update(ctx context.Context, req resource.Update
 
 	tflog.Trace(ctx, "updated a rubric category resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &updatedRubricCategoryResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: updatedRubricCategoryResourceModel.Id})...)
 }
 
 func (r *RubricCategoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	defer r.cache.invalidate(lookupKindCategory, lookupKindScorecardCategory)
+
 	data := read[RubricCategoryResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -141,5 +167,5 @@ func (r *RubricCategoryResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *RubricCategoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -19,6 +19,8 @@ var _ resource.ResourceWithConfigure = &RubricLevelResource{}
 
 var _ resource.ResourceWithImportState = &RubricLevelResource{}
 
+var _ resource.ResourceWithIdentity = &RubricLevelResource{}
+
 func NewRubricLevelResource() resource.Resource {
 	return &RubricLevelResource{}
 }
@@ -82,7 +84,17 @@ func (r *RubricLevelResource) Schema(ctx context.Context, req resource.SchemaReq
 	}
 }
 
+func (r *RubricLevelResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *RubricLevelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	defer r.cache.invalidate(lookupKindLevel)
+
 	planModel := read[RubricLevelResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -106,6 +118,7 @@ func (r *RubricLevelResource) Create(ctx context.Context, req resource.CreateReq
 
 	tflog.Trace(ctx, "created a rubric level resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &createdRubricLevelResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: createdRubricLevelResourceModel.Id})...)
 }
 
 func (r *RubricLevelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -115,11 +128,10 @@ func (r *RubricLevelResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	rubricLevel, err := r.client.GetLevel(asID(stateModel.Id))
+	if removeIfNotFound(ctx, resp, err, rubricLevel != nil && rubricLevel.Id != "") {
+		return
+	}
 	if err != nil {
-		if (rubricLevel == nil || rubricLevel.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read rubric level, got error: %s", err))
 		return
 	}
@@ -127,9 +139,16 @@ func (r *RubricLevelResource) Read(ctx context.Context, req resource.ReadRequest
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &readRubricLevelResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: readRubricLevelResourceModel.Id})...)
 }
 
 func (r *RubricLevelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	defer r.cache.invalidate(lookupKindLevel)
+
 	planModel := read[RubricLevelResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -148,9 +167,16 @@ func (r *RubricLevelResource) Update(ctx context.Context, req resource.UpdateReq
 
 	tflog.Trace(ctx, "updated a rubric level resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &updatedRubricLevelResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: updatedRubricLevelResourceModel.Id})...)
 }
 
 func (r *RubricLevelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	defer r.cache.invalidate(lookupKindLevel)
+
 	data := read[RubricLevelResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -165,5 +191,5 @@ func (r *RubricLevelResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *RubricLevelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
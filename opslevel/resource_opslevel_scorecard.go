@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -19,6 +18,8 @@ var _ resource.ResourceWithConfigure = &ScorecardResource{}
 
 var _ resource.ResourceWithImportState = &ScorecardResource{}
 
+var _ resource.ResourceWithIdentity = &ScorecardResource{}
+
 func NewScorecardResource() resource.Resource {
 	return &ScorecardResource{}
 }
@@ -127,7 +128,15 @@ func (r *ScorecardResource) Schema(ctx context.Context, req resource.SchemaReque
 	}
 }
 
+func (r *ScorecardResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *ScorecardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[ScorecardResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -144,7 +153,7 @@ func (r *ScorecardResource) Create(ctx context.Context, req resource.CreateReque
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to create scorecard, got error: %s", err))
 		return
 	}
-	categoryIds, err := getScorecardCategoyIds(r.client, *scorecard)
+	categoryIds, err := getScorecardCategoyIds(r.cache, r.client, scorecard)
 	if err != nil {
 		resp.Diagnostics.AddWarning("opslevel client error", fmt.Sprintf("Unable to retrieve category ids from scorecard, got error: %s", err))
 	}
@@ -152,16 +161,17 @@ func (r *ScorecardResource) Create(ctx context.Context, req resource.CreateReque
 
 	tflog.Trace(ctx, "created a scorecard resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &createdScorecardResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: createdScorecardResourceModel.Id})...)
 }
 
-func getScorecardCategoyIds(client *opslevel.Client, scorecard opslevel.Scorecard) ([]string, error) {
+func getScorecardCategoyIds(cache *lookupCache, client *opslevel.Client, scorecard *opslevel.Scorecard) ([]string, error) {
 	var categoryIds []string
 
-	result, err := scorecard.ListCategories(client, nil)
+	categories, err := cache.listScorecardCategories(client, scorecard)
 	if err != nil {
 		return categoryIds, err
 	}
-	for _, category := range result.Nodes {
+	for _, category := range categories {
 		categoryIds = append(categoryIds, string(category.Id))
 	}
 
@@ -175,15 +185,14 @@ func (r *ScorecardResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	readScorecard, err := r.client.GetScorecard(stateModel.Id.ValueString())
-	if err != nil || readScorecard == nil {
-		if (readScorecard == nil || readScorecard.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
+	if removeIfNotFound(ctx, resp, err, readScorecard != nil && readScorecard.Id != "") {
+		return
+	}
+	if err != nil {
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read scorecard, got error: %s", err))
 		return
 	}
-	categoryIds, err := getScorecardCategoyIds(r.client, *readScorecard)
+	categoryIds, err := getScorecardCategoyIds(r.cache, r.client, readScorecard)
 	if err != nil {
 		resp.Diagnostics.AddWarning("opslevel client error", fmt.Sprintf("Unable to retrieve category ids from scorecard, got error: %s", err))
 	}
@@ -191,9 +200,14 @@ func (r *ScorecardResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &readScorecardResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: readScorecardResourceModel.Id})...)
 }
 
 func (r *ScorecardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[ScorecardResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	stateModel := read[ScorecardResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
@@ -217,7 +231,7 @@ func (r *ScorecardResource) Update(ctx context.Context, req resource.UpdateReque
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to update scorecard, got error: %s", err))
 		return
 	}
-	categoryIds, err := getScorecardCategoyIds(r.client, *scorecard)
+	categoryIds, err := getScorecardCategoyIds(r.cache, r.client, scorecard)
 	if err != nil {
 		resp.Diagnostics.AddWarning("opslevel client error", fmt.Sprintf("Unable to retrieve category ids from scorecard, got error: %s", err))
 	}
@@ -225,9 +239,15 @@ func (r *ScorecardResource) Update(ctx context.Context, req resource.UpdateReque
 
 	tflog.Trace(ctx, "updated a scorecard resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &updatedScorecardResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: updatedScorecardResourceModel.Id})...)
 }
 
 func (r *ScorecardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+	defer r.cache.invalidate(lookupKindScorecardCategory)
+
 	data := read[ScorecardResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -242,5 +262,5 @@ func (r *ScorecardResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *ScorecardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateWithAlias(ctx, r.client, opslevel.AliasOwnerTypeEnumScorecard, req, resp)
 }
@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/opslevel/opslevel-go/v2026"
@@ -19,6 +22,8 @@ var _ resource.ResourceWithConfigure = &SecretResource{}
 
 var _ resource.ResourceWithImportState = &SecretResource{}
 
+var _ resource.ResourceWithIdentity = &SecretResource{}
+
 func NewSecretResource() resource.Resource {
 	return &SecretResource{}
 }
@@ -30,22 +35,26 @@ type SecretResource struct {
 
 // SecretResourceModel describes the Secret managed resource.
 type SecretResourceModel struct {
-	Alias     types.String `tfsdk:"alias"`
-	CreatedAt types.String `tfsdk:"created_at"`
-	Id        types.String `tfsdk:"id"`
-	Owner     types.String `tfsdk:"owner"`
-	UpdatedAt types.String `tfsdk:"updated_at"`
-	Value     types.String `tfsdk:"value"`
+	Alias          types.String `tfsdk:"alias"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	Id             types.String `tfsdk:"id"`
+	Owner          types.String `tfsdk:"owner"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+	Value          types.String `tfsdk:"value"`
+	ValueWo        types.String `tfsdk:"value_wo"`
+	ValueWoVersion types.Int64  `tfsdk:"value_wo_version"`
 }
 
-func NewSecretResourceModel(secret opslevel.Secret, ownerIdentifier, sensitiveValue string) SecretResourceModel {
+func NewSecretResourceModel(secret opslevel.Secret, givenModel SecretResourceModel) SecretResourceModel {
 	return SecretResourceModel{
-		Alias:     RequiredStringValue(secret.Alias),
-		CreatedAt: ComputedStringValue(secret.Timestamps.CreatedAt.Local().Format(time.RFC850)),
-		Id:        ComputedStringValue(string(secret.Id)),
-		Owner:     RequiredStringValue(ownerIdentifier),
-		UpdatedAt: ComputedStringValue(secret.Timestamps.UpdatedAt.Local().Format(time.RFC850)),
-		Value:     RequiredStringValue(sensitiveValue),
+		Alias:          RequiredStringValue(secret.Alias),
+		CreatedAt:      ComputedStringValue(secret.Timestamps.CreatedAt.Local().Format(time.RFC850)),
+		Id:             ComputedStringValue(string(secret.Id)),
+		Owner:          givenModel.Owner,
+		UpdatedAt:      ComputedStringValue(secret.Timestamps.UpdatedAt.Local().Format(time.RFC850)),
+		Value:          givenModel.Value,
+		ValueWo:        types.StringNull(),
+		ValueWoVersion: givenModel.ValueWoVersion,
 	}
 }
 
@@ -86,32 +95,68 @@ func (r *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:    true,
 			},
 			"value": schema.StringAttribute{
-				Description: "A sensitive value",
+				Description: "A sensitive value. Conflicts with `value_wo`.",
 				Sensitive:   true,
-				Required:    true,
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("value"), path.MatchRoot("value_wo")),
+				},
+			},
+			"value_wo": schema.StringAttribute{
+				Description: "A sensitive value that is never stored in the plan or state. Requires Terraform 1.11 or later. Conflicts with `value`.",
+				Sensitive:   true,
+				Optional:    true,
+				WriteOnly:   true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("value_wo_version")),
+				},
+			},
+			"value_wo_version": schema.Int64Attribute{
+				Description: "The version of `value_wo`. Terraform cannot detect changes to write-only values, so increment this to send a new `value_wo` to OpsLevel.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("value_wo")),
+				},
 			},
 		},
 	}
 }
 
+func (r *SecretResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *SecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	data := read[SecretResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	value := data.Value
+	if value.IsNull() {
+		value = writeOnlyStringValue(ctx, &resp.Diagnostics, req.Config, "value_wo")
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	secret, err := r.client.CreateSecret(data.Alias.ValueString(), opslevel.SecretInput{
 		Owner: opslevel.NewIdentifier(data.Owner.ValueString()),
-		Value: opslevel.RefOf(data.Value.ValueString()),
+		Value: opslevel.RefOf(value.ValueString()),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to create secret, got error: %s", err))
 		return
 	}
-	createdSecretResourceModel := NewSecretResourceModel(*secret, data.Owner.ValueString(), data.Value.ValueString())
+	createdSecretResourceModel := NewSecretResourceModel(*secret, data)
 
 	tflog.Trace(ctx, "created a secret resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &createdSecretResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: createdSecretResourceModel.Id})...)
 }
 
 func (r *SecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -121,41 +166,63 @@ func (r *SecretResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	secret, err := r.client.GetSecret(data.Id.ValueString())
+	if removeIfNotFound(ctx, resp, err, secret != nil && secret.Id != "") {
+		return
+	}
 	if err != nil {
-		if (secret == nil || secret.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read secret, got error: %s", err))
 		return
 	}
-	readSecretResourceModel := NewSecretResourceModel(*secret, data.Owner.ValueString(), data.Value.ValueString())
+	readSecretResourceModel := NewSecretResourceModel(*secret, data)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &readSecretResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: readSecretResourceModel.Id})...)
 }
 
 func (r *SecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	data := read[SecretResourceModel](ctx, &resp.Diagnostics, req.Plan)
+	stateModel := read[SecretResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	updatedSecret, err := r.client.UpdateSecret(data.Id.ValueString(), opslevel.SecretInput{
+	input := opslevel.SecretInput{
 		Owner: opslevel.NewIdentifier(data.Owner.ValueString()),
-		Value: opslevel.RefOf(data.Value.ValueString()),
-	})
+	}
+	switch {
+	case !data.Value.IsNull():
+		input.Value = opslevel.RefOf(data.Value.ValueString())
+	case !data.ValueWoVersion.Equal(stateModel.ValueWoVersion):
+		// write-only values are only rotated when their version changes
+		valueWo := writeOnlyStringValue(ctx, &resp.Diagnostics, req.Config, "value_wo")
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		input.Value = opslevel.RefOf(valueWo.ValueString())
+	}
+
+	updatedSecret, err := r.client.UpdateSecret(data.Id.ValueString(), input)
 	if err != nil {
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to update secret, got error: %s", err))
 		return
 	}
-	updatedSecretResourceModel := NewSecretResourceModel(*updatedSecret, data.Owner.ValueString(), data.Value.ValueString())
+	updatedSecretResourceModel := NewSecretResourceModel(*updatedSecret, data)
 
 	tflog.Trace(ctx, "updated a secret resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &updatedSecretResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: updatedSecretResourceModel.Id})...)
 }
 
 func (r *SecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	data := read[SecretResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -170,5 +237,5 @@ func (r *SecretResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *SecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
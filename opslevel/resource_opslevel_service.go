@@ -7,6 +7,7 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -25,6 +26,10 @@ var _ resource.ResourceWithConfigure = &ServiceResource{}
 
 var _ resource.ResourceWithImportState = &ServiceResource{}
 
+var _ resource.ResourceWithIdentity = &ServiceResource{}
+
+var _ resource.ResourceWithModifyPlan = &ServiceResource{}
+
 func NewServiceResource() resource.Resource {
 	return &ServiceResource{}
 }
@@ -36,25 +41,34 @@ type ServiceResource struct {
 
 // ServiceResourceModel describes the Service managed resource.
 type ServiceResourceModel struct {
-	Aliases                    types.Set    `tfsdk:"aliases"`
-	ApiDocumentPath            types.String `tfsdk:"api_document_path"`
-	Description                types.String `tfsdk:"description"`
-	Framework                  types.String `tfsdk:"framework"`
-	Id                         types.String `tfsdk:"id"`
-	Language                   types.String `tfsdk:"language"`
-	LifecycleAlias             types.String `tfsdk:"lifecycle_alias"`
-	Name                       types.String `tfsdk:"name"`
-	Note                       types.String `tfsdk:"note"`
-	Owner                      types.String `tfsdk:"owner"`
-	Parent                     types.String `tfsdk:"parent"`
-	PreferredApiDocumentSource types.String `tfsdk:"preferred_api_document_source"`
-	Product                    types.String `tfsdk:"product"`
-	Tags                       types.Set    `tfsdk:"tags"`
-	TierAlias                  types.String `tfsdk:"tier_alias"`
-	Type                       types.String `tfsdk:"type"`
+	Aliases                    types.Set      `tfsdk:"aliases"`
+	ApiDocumentPath            types.String   `tfsdk:"api_document_path"`
+	Description                types.String   `tfsdk:"description"`
+	Framework                  types.String   `tfsdk:"framework"`
+	Id                         types.String   `tfsdk:"id"`
+	Language                   types.String   `tfsdk:"language"`
+	LifecycleAlias             types.String   `tfsdk:"lifecycle_alias"`
+	Name                       types.String   `tfsdk:"name"`
+	Note                       types.String   `tfsdk:"note"`
+	Owner                      types.String   `tfsdk:"owner"`
+	Parent                     types.String   `tfsdk:"parent"`
+	PreferredApiDocumentSource types.String   `tfsdk:"preferred_api_document_source"`
+	Product                    types.String   `tfsdk:"product"`
+	Tags                       types.Set      `tfsdk:"tags"`
+	TagsAll                    types.Set      `tfsdk:"tags_all"`
+	TierAlias                  types.String   `tfsdk:"tier_alias"`
+	Timeouts                   timeouts.Value `tfsdk:"timeouts"`
+	Type                       types.String   `tfsdk:"type"`
+}
+
+// serviceReferences are the objects a service refers to that can be checked during plan
+var serviceReferences = []plannedReference{
+	{attribute: "lifecycle_alias", kind: lookupKindLifecycle},
+	{attribute: "owner", kind: lookupKindTeam},
+	{attribute: "tier_alias", kind: lookupKindTier},
 }
 
-func newServiceResourceModel(ctx context.Context, service opslevel.Service, givenModel ServiceResourceModel) (ServiceResourceModel, diag.Diagnostics) {
+func newServiceResourceModel(ctx context.Context, service opslevel.Service, givenModel ServiceResourceModel, tags *tagConfig) (ServiceResourceModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	serviceResourceModel := ServiceResourceModel{
 		ApiDocumentPath: OptionalStringValue(service.ApiDocumentPath),
@@ -67,6 +81,7 @@ func newServiceResourceModel(ctx context.Context, service opslevel.Service, give
 		Note:            OptionalStringValue(service.Note),
 		Product:         OptionalStringValue(service.Product),
 		TierAlias:       OptionalStringValue(service.Tier.Alias),
+		Timeouts:        givenModel.Timeouts,
 		Type:            OptionalStringValue(givenModel.Type.ValueString()),
 	}
 
@@ -92,13 +107,13 @@ func newServiceResourceModel(ctx context.Context, service opslevel.Service, give
 		serviceResourceModel.Aliases = givenModel.Aliases
 	}
 
-	if givenModel.Tags.IsNull() && (service.Tags != nil || len(service.Tags.Nodes) == 0) {
-		serviceResourceModel.Tags = types.SetNull(types.StringType)
-	} else {
-		serviceResourceModel.Tags, diags = types.SetValueFrom(ctx, types.StringType, flattenTagArray(service.Tags.Nodes))
-		if diags.HasError() {
-			return serviceResourceModel, diags
-		}
+	var serviceTags []opslevel.Tag
+	if service.Tags != nil {
+		serviceTags = service.Tags.Nodes
+	}
+	serviceResourceModel.Tags, serviceResourceModel.TagsAll, diags = tags.resourceTags(ctx, serviceTags, givenModel.Tags)
+	if diags.HasError() {
+		return serviceResourceModel, diags
 	}
 
 	if service.PreferredApiDocumentSource != nil {
@@ -216,6 +231,11 @@ func (r *ServiceResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Optional:    true,
 				Validators:  []validator.Set{TagFormatValidator()},
 			},
+			"tags_all": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "All tags on the service, including those inherited from the provider `default_tags` block.",
+				Computed:    true,
+			},
 			"tier_alias": schema.StringAttribute{
 				Description: "The software tier that the service belongs to.",
 				Optional:    true,
@@ -231,15 +251,60 @@ func (r *ServiceResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, reconcileTimeouts),
+		},
+	}
+}
+
+// ModifyPlan shows the tags inherited from the provider default_tags in the plan,
+// and checks that the owner, lifecycle and tier exist when the provider sets validate_references
+func (r *ServiceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.validatePlannedReferences(ctx, req.Plan, &resp.Diagnostics, serviceReferences)
+
+	var givenTags types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &givenTags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	priorTagsAll := types.SetNull(types.StringType)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("tags_all"), &priorTagsAll)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
 	}
+
+	tagsAll, diags := r.tags.plannedTagsAll(ctx, givenTags, priorTagsAll)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+}
+
+func (r *ServiceResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
 }
 
 func (r *ServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[ServiceResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, client := r.startOperation(ctx, planModel.Timeouts.Create, reconcileTimeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	input := opslevel.ServiceCreateInput{
 		Description:    nullable(planModel.Description.ValueStringPointer()),
 		Framework:      nullable(planModel.Framework.ValueStringPointer()),
@@ -264,7 +329,7 @@ func (r *ServiceResource) Create(ctx context.Context, req resource.CreateRequest
 		input.Type = opslevel.NewIdentifier(planModel.Type.ValueString())
 	}
 
-	service, err := r.client.CreateService(input)
+	service, err := client.CreateService(input)
 	if err != nil || service == nil {
 		title, detail := formatOpslevelError("create service", err)
 		resp.Diagnostics.AddError(title, detail)
@@ -282,18 +347,18 @@ func (r *ServiceResource) Create(ctx context.Context, req resource.CreateRequest
 		// add "unique identifiers" (OpsLevel created aliases) before reconciling.
 		// this ensures that we don't try to create an alias that already exists
 		aliases = append(aliases, service.UniqueIdentifiers()...)
-		if err = service.ReconcileAliases(r.client, aliases); err != nil {
+		if err = service.ReconcileAliases(client, aliases); err != nil {
 			resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to reconcile service aliases: '%s'\n%s", aliases, err))
 
 			// delete newly created team to avoid dupliate team creation on next 'terraform apply'
-			if err := r.client.DeleteService(string(service.Id)); err != nil {
+			if err := client.DeleteService(string(service.Id)); err != nil {
 				resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("failed to delete incorrectly created service '%s' following aliases error:\n%s", service.Name, err))
 			}
 			return
 		}
 	}
 
-	if _, err := updateServiceNote(*r.client, *service, planModel); err != nil {
+	if _, err := updateServiceNote(*client, *service, planModel); err != nil {
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to update service note, got error: %s", err))
 		return
 	}
@@ -304,21 +369,21 @@ func (r *ServiceResource) Create(ctx context.Context, req resource.CreateRequest
 		resp.Diagnostics.AddError("Config error", fmt.Sprintf("Unable to handle given service tags: '%s'", planModel.Tags))
 		return
 	}
-	if err = r.client.ReconcileTags(service, givenTags); err != nil {
+	if err = r.tags.reconcile(client, service, givenTags, !planModel.Tags.IsNull()); err != nil {
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to reconcile service tags '%s', got error: %s", givenTags, err))
 		return
 	}
 
 	if !planModel.ApiDocumentPath.IsNull() || !planModel.PreferredApiDocumentSource.IsNull() {
 		apiDocPath, sourceEnum := serviceApiDocSettingsUpdateInput(planModel)
-		if _, err := r.client.ServiceApiDocSettingsUpdate(string(service.Id), apiDocPath, sourceEnum); err != nil {
+		if _, err := client.ServiceApiDocSettingsUpdate(string(service.Id), apiDocPath, sourceEnum); err != nil {
 			resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to update API document settings for service %s. error: %s", service.Name, err))
 			return
 		}
 	}
 
 	// fetch the service again, since other mutations are performed after the create/update step
-	service, err = r.client.GetService(string(service.Id))
+	service, err = client.GetService(string(service.Id))
 	if err != nil {
 		if (service == nil || service.Id == "") && opslevel.IsOpsLevelApiError(err) {
 			resp.State.RemoveResource(ctx)
@@ -328,7 +393,7 @@ func (r *ServiceResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	newStateModel, diags := newServiceResourceModel(ctx, *service, planModel)
+	newStateModel, diags := newServiceResourceModel(ctx, *service, planModel, r.tags)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -337,6 +402,7 @@ func (r *ServiceResource) Create(ctx context.Context, req resource.CreateRequest
 
 	tflog.Trace(ctx, "created a service resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: newStateModel.Id})...)
 }
 
 func (r *ServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -345,18 +411,22 @@ func (r *ServiceResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	service, err := r.client.GetService(stateModel.Id.ValueString())
+	ctx, cancel, client := r.startOperation(ctx, stateModel.Timeouts.Read, reconcileTimeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	service, err := client.GetService(stateModel.Id.ValueString())
+	if removeIfNotFound(ctx, resp, err, service != nil && service.Id != "") {
+		return
+	}
 	if err != nil {
 		title, detail := formatOpslevelError("read service", err)
 		resp.Diagnostics.AddError(title, detail)
 		return
 	}
-	if service.Id == "" {
-		resp.State.RemoveResource(ctx)
-		return
-	}
 
-	newStateModel, diags := newServiceResourceModel(ctx, *service, stateModel)
+	newStateModel, diags := newServiceResourceModel(ctx, *service, stateModel, r.tags)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -364,6 +434,7 @@ func (r *ServiceResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: newStateModel.Id})...)
 }
 
 func unsetStringHelper(plan, state basetypes.StringValue) *opslevel.Nullable[string] {
@@ -394,12 +465,22 @@ func unsetIdentifierHelper(plan, state basetypes.StringValue) *opslevel.Identifi
 }
 
 func (r *ServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[ServiceResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	stateModel := read[ServiceResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, client := r.startOperation(ctx, planModel.Timeouts.Update, reconcileTimeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	serviceUpdateInput := opslevel.ServiceUpdateInput{
 		Description:    unsetStringHelper(planModel.Description, stateModel.Description),
 		Framework:      unsetStringHelper(planModel.Framework, stateModel.Framework),
@@ -415,7 +496,7 @@ func (r *ServiceResource) Update(ctx context.Context, req resource.UpdateRequest
 		Type:       unsetIdentifierHelper(planModel.Type, stateModel.Type),
 	}
 
-	service, err := r.client.UpdateService(serviceUpdateInput)
+	service, err := client.UpdateService(serviceUpdateInput)
 	if err != nil {
 		title, detail := formatOpslevelError("update service", err)
 		resp.Diagnostics.AddError(title, detail)
@@ -434,7 +515,7 @@ func (r *ServiceResource) Update(ctx context.Context, req resource.UpdateRequest
 	uniqueIdentifiers := service.UniqueIdentifiers()
 	for _, uniqueIdentifier := range uniqueIdentifiers {
 		if !slices.Contains(aliases, uniqueIdentifier) {
-			_ = r.client.DeleteAlias(opslevel.AliasDeleteInput{
+			_ = client.DeleteAlias(opslevel.AliasDeleteInput{
 				Alias:     uniqueIdentifier,
 				OwnerType: opslevel.AliasOwnerTypeEnumService,
 			})
@@ -443,13 +524,13 @@ func (r *ServiceResource) Update(ctx context.Context, req resource.UpdateRequest
 	// add "unique identifiers" (OpsLevel created aliases) before reconciling.
 	// this ensures that we don't try to create an alias that already exists
 	aliases = append(aliases, uniqueIdentifiers...)
-	if err = service.ReconcileAliases(r.client, aliases); err != nil {
+	if err = service.ReconcileAliases(client, aliases); err != nil {
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to reconcile service aliases: '%s'\n%s", aliases, err))
 	}
 
 	// update service note only if known to plan and/or state
 	if !planModel.Note.IsNull() || !stateModel.Note.IsNull() {
-		if _, err := updateServiceNote(*r.client, *service, planModel); err != nil {
+		if _, err := updateServiceNote(*client, *service, planModel); err != nil {
 			resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to update service note, got error: %s", err))
 			return
 		}
@@ -462,8 +543,9 @@ func (r *ServiceResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	if !stateModel.Tags.IsNull() || !planModel.Tags.IsNull() {
-		if err = r.client.ReconcileTags(service, givenTags); err != nil {
+	if !stateModel.Tags.IsNull() || !planModel.Tags.IsNull() || r.tags.hasDefaults() {
+		authoritative := !stateModel.Tags.IsNull() || !planModel.Tags.IsNull()
+		if err = r.tags.reconcile(client, service, givenTags, authoritative); err != nil {
 			resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to reconcile service tags '%s', got error: %s", givenTags, err))
 			return
 		}
@@ -472,20 +554,20 @@ func (r *ServiceResource) Update(ctx context.Context, req resource.UpdateRequest
 	if !planModel.ApiDocumentPath.Equal(stateModel.ApiDocumentPath) ||
 		!planModel.PreferredApiDocumentSource.Equal(stateModel.PreferredApiDocumentSource) {
 		apiDocPath, sourceEnum := serviceApiDocSettingsUpdateInput(planModel)
-		if _, err := r.client.ServiceApiDocSettingsUpdate(string(service.Id), apiDocPath, sourceEnum); err != nil {
+		if _, err := client.ServiceApiDocSettingsUpdate(string(service.Id), apiDocPath, sourceEnum); err != nil {
 			resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to update API document settings for service %s. error: %s", service.Name, err))
 			return
 		}
 	}
 
 	// fetch the service again, since other mutations are performed after the create/update step
-	service, err = r.client.GetService(string(service.Id))
+	service, err = client.GetService(string(service.Id))
 	if err != nil {
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to get service after update, got error: %s", err))
 		return
 	}
 
-	newStateModel, diags := newServiceResourceModel(ctx, *service, planModel)
+	newStateModel, diags := newServiceResourceModel(ctx, *service, planModel, r.tags)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -494,15 +576,25 @@ func (r *ServiceResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	tflog.Trace(ctx, "updated a service resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: newStateModel.Id})...)
 }
 
 func (r *ServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[ServiceResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	err := r.client.DeleteService(stateModel.Id.ValueString())
+	ctx, cancel, client := r.startOperation(ctx, stateModel.Timeouts.Delete, reconcileTimeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := client.DeleteService(stateModel.Id.ValueString())
 	if err != nil {
 		title, detail := formatOpslevelError("delete service", err)
 		resp.Diagnostics.AddError(title, detail)
@@ -512,7 +604,7 @@ func (r *ServiceResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *ServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateWithAlias(ctx, r.client, opslevel.AliasOwnerTypeEnumService, req, resp)
 }
 
 func updateServiceNote(client opslevel.Client, service opslevel.Service, planModel ServiceResourceModel) (*opslevel.Service, error) {
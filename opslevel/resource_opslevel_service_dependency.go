@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -21,6 +22,8 @@ var _ resource.ResourceWithConfigure = &ServiceDependencyResource{}
 
 var _ resource.ResourceWithImportState = &ServiceDependencyResource{}
 
+var _ resource.ResourceWithIdentity = &ServiceDependencyResource{}
+
 func NewServiceDependencyResource() resource.Resource {
 	return &ServiceDependencyResource{}
 }
@@ -38,6 +41,19 @@ type ServiceDependencyResourceModel struct {
 	Service     types.String `tfsdk:"service"`
 }
 
+// serviceDependencyIdentityModel identifies a service dependency by the two services it links
+type serviceDependencyIdentityModel struct {
+	DependsUpon types.String `tfsdk:"depends_upon"`
+	Service     types.String `tfsdk:"service"`
+}
+
+func (m ServiceDependencyResourceModel) identity() serviceDependencyIdentityModel {
+	return serviceDependencyIdentityModel{
+		DependsUpon: m.DependsUpon,
+		Service:     m.Service,
+	}
+}
+
 func NewServiceDependencyResourceModel(serviceDependency opslevel.ServiceDependency, givenModel ServiceDependencyResourceModel) (ServiceDependencyResourceModel, diag.Diagnostics) {
 	var diag diag.Diagnostics
 	serviceDependencyResourceModel := ServiceDependencyResourceModel{
@@ -115,7 +131,26 @@ func (r *ServiceDependencyResource) Schema(ctx context.Context, req resource.Sch
 	}
 }
 
+func (r *ServiceDependencyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"depends_upon": identityschema.StringAttribute{
+				Description:       "The ID of the service that is depended upon.",
+				RequiredForImport: true,
+			},
+			"service": identityschema.StringAttribute{
+				Description:       "The ID of the service with the dependency.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *ServiceDependencyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[ServiceDependencyResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -142,6 +177,7 @@ func (r *ServiceDependencyResource) Create(ctx context.Context, req resource.Cre
 
 	tflog.Trace(ctx, "created a service dependency resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, stateModel.identity())...)
 }
 
 func (r *ServiceDependencyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -158,6 +194,9 @@ func (r *ServiceDependencyResource) Read(ctx context.Context, req resource.ReadR
 	} else {
 		service, err = r.client.GetServiceWithAlias(serviceIdentifier)
 	}
+	if removeIfNotFound(ctx, resp, err, service != nil && service.Id != "") {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read service, got error: %s", err))
 		return
@@ -169,8 +208,7 @@ func (r *ServiceDependencyResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 	extractedServiceDependency := extractServiceDependency(planModel.Id.ValueString(), *dependencies)
-	if extractedServiceDependency == nil {
-		resp.Diagnostics.AddError("opslevel client error", "Unable to extract service dependency")
+	if removeIfNotFound(ctx, resp, nil, extractedServiceDependency != nil) {
 		return
 	}
 
@@ -194,6 +232,7 @@ func (r *ServiceDependencyResource) Read(ctx context.Context, req resource.ReadR
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &readServiceDependencyResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, readServiceDependencyResourceModel.identity())...)
 }
 
 func extractServiceDependency(id string, serviceDependencies opslevel.ServiceDependenciesConnection) *opslevel.ServiceDependenciesEdge {
@@ -206,11 +245,19 @@ func extractServiceDependency(id string, serviceDependencies opslevel.ServiceDep
 }
 
 func (r *ServiceDependencyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	resp.Diagnostics.AddError("terraform plugin error",
 		"service dependencies should never be updated, only replaced.\nplease file a bug report including your .tf file at: github.com/OpsLevel/terraform-provider-opslevel")
 }
 
 func (r *ServiceDependencyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[ServiceDependencyResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -224,15 +271,24 @@ func (r *ServiceDependencyResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *ServiceDependencyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	if !isTagValid(req.ID) {
+	importID := req.ID
+	if importID == "" {
+		identity := read[serviceDependencyIdentityModel](ctx, &resp.Diagnostics, req.Identity)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		importID = identity.Service.ValueString() + ":" + identity.DependsUpon.ValueString()
+	}
+
+	if !isTagValid(importID) {
 		resp.Diagnostics.AddError(
 			"Invalid format for given Import Id",
-			fmt.Sprintf("Id expected to be formatted as '<service-id>:<dependency-id>'. Given '%s'", req.ID),
+			fmt.Sprintf("Id expected to be formatted as '<service-id>:<dependency-id>'. Given '%s'", importID),
 		)
 		return
 	}
 
-	ids := strings.Split(req.ID, ":")
+	ids := strings.Split(importID, ":")
 	serviceId := ids[0]
 	dependencyId := ids[1]
 
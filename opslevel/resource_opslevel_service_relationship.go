@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -21,6 +22,8 @@ var _ resource.ResourceWithConfigure = &ServiceRelationshipResource{}
 
 var _ resource.ResourceWithImportState = &ServiceRelationshipResource{}
 
+var _ resource.ResourceWithIdentity = &ServiceRelationshipResource{}
+
 func NewServiceRelationshipResource() resource.Resource {
 	return &ServiceRelationshipResource{}
 }
@@ -36,6 +39,19 @@ type ServiceRelationshipResourceModel struct {
 	System  types.String `tfsdk:"system"`
 }
 
+// serviceRelationshipIdentityModel identifies a service relationship by its service and system
+type serviceRelationshipIdentityModel struct {
+	Service types.String `tfsdk:"service"`
+	System  types.String `tfsdk:"system"`
+}
+
+func (m ServiceRelationshipResourceModel) identity() serviceRelationshipIdentityModel {
+	return serviceRelationshipIdentityModel{
+		Service: m.Service,
+		System:  m.System,
+	}
+}
+
 func NewServiceRelationshipResourceModel(service *opslevel.Service, givenModel ServiceRelationshipResourceModel) ServiceRelationshipResourceModel {
 	return ServiceRelationshipResourceModel{
 		Service: givenModel.Service,
@@ -45,6 +61,8 @@ func NewServiceRelationshipResourceModel(service *opslevel.Service, givenModel S
 
 func (r *ServiceRelationshipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_service_relationship"
+	// the system can be updated in place
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *ServiceRelationshipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -68,7 +86,26 @@ func (r *ServiceRelationshipResource) Schema(ctx context.Context, req resource.S
 	}
 }
 
+func (r *ServiceRelationshipResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"service": identityschema.StringAttribute{
+				Description:       "The ID or alias of the service.",
+				RequiredForImport: true,
+			},
+			"system": identityschema.StringAttribute{
+				Description:       "The ID or alias of the system tied to the service.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *ServiceRelationshipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	var diag diag.Diagnostics
 
 	planModel := read[ServiceRelationshipResourceModel](ctx, &resp.Diagnostics, req.Plan)
@@ -103,6 +140,7 @@ func (r *ServiceRelationshipResource) Create(ctx context.Context, req resource.C
 
 	tflog.Trace(ctx, "created a service relationship resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, stateModel.identity())...)
 }
 
 func (r *ServiceRelationshipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -114,12 +152,11 @@ func (r *ServiceRelationshipResource) Read(ctx context.Context, req resource.Rea
 	systemIdentifier := stateModel.System.ValueString()
 
 	service, err := getService(r.client, serviceIdentifier)
-	if err != nil {
-		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read service, got error: %s", err))
+	if removeIfNotFound(ctx, resp, err, service != nil && service.Id != "" && service.Parent != nil) {
 		return
 	}
-	if service.Parent == nil {
-		resp.State.RemoveResource(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read service, got error: %s", err))
 		return
 	}
 	if (opslevel.IsID(systemIdentifier) && string(service.Parent.Id) != systemIdentifier) &&
@@ -135,9 +172,14 @@ func (r *ServiceRelationshipResource) Read(ctx context.Context, req resource.Rea
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, stateModel.identity())...)
 }
 
 func (r *ServiceRelationshipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	var diag diag.Diagnostics
 
 	planModel := read[ServiceRelationshipResourceModel](ctx, &resp.Diagnostics, req.Plan)
@@ -172,9 +214,14 @@ func (r *ServiceRelationshipResource) Update(ctx context.Context, req resource.U
 
 	tflog.Trace(ctx, "updated a service relationship resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, stateModel.identity())...)
 }
 
 func (r *ServiceRelationshipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[ServiceRelationshipResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -207,15 +254,24 @@ func (r *ServiceRelationshipResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *ServiceRelationshipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	if !hasTagFormat(req.ID) {
+	importID := req.ID
+	if importID == "" {
+		identity := read[serviceRelationshipIdentityModel](ctx, &resp.Diagnostics, req.Identity)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		importID = identity.Service.ValueString() + ":" + identity.System.ValueString()
+	}
+
+	if !hasTagFormat(importID) {
 		resp.Diagnostics.AddError(
 			"Invalid format for given Import Id",
-			fmt.Sprintf("Id expected to be formatted as '<service-identifier>:<system-identifier>'. Given '%s'", req.ID),
+			fmt.Sprintf("Id expected to be formatted as '<service-identifier>:<system-identifier>'. Given '%s'", importID),
 		)
 		return
 	}
 
-	ids := strings.Split(req.ID, ":")
+	ids := strings.Split(importID, ":")
 	serviceIdentifier := ids[0]
 	systemIdentifier := ids[1]
 
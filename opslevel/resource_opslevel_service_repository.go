@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -23,6 +24,8 @@ var _ resource.ResourceWithConfigure = &ServiceRepositoryResource{}
 
 var _ resource.ResourceWithImportState = &ServiceRepositoryResource{}
 
+var _ resource.ResourceWithIdentity = &ServiceRepositoryResource{}
+
 func NewServiceRepositoryResource() resource.Resource {
 	return &ServiceRepositoryResource{}
 }
@@ -43,6 +46,19 @@ type ServiceRepositoryResourceModel struct {
 	ServiceAlias    types.String `tfsdk:"service_alias"`
 }
 
+// serviceRepositoryIdentityModel identifies a service repository by its service and repository
+type serviceRepositoryIdentityModel struct {
+	Repository types.String `tfsdk:"repository"`
+	Service    types.String `tfsdk:"service"`
+}
+
+func (m ServiceRepositoryResourceModel) identity() serviceRepositoryIdentityModel {
+	return serviceRepositoryIdentityModel{
+		Repository: identifierValue(m.Repository, m.RepositoryAlias),
+		Service:    identifierValue(m.Service, m.ServiceAlias),
+	}
+}
+
 func NewServiceRepositoryResourceModel(ctx context.Context, serviceRepository opslevel.ServiceRepository, planModel ServiceRepositoryResourceModel) ServiceRepositoryResourceModel {
 	stateModel := ServiceRepositoryResourceModel{
 		BaseDirectory: OptionalStringValue(serviceRepository.BaseDirectory),
@@ -154,7 +170,26 @@ func (r *ServiceRepositoryResource) Schema(ctx context.Context, req resource.Sch
 	}
 }
 
+func (r *ServiceRepositoryResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"repository": identityschema.StringAttribute{
+				Description:       "The id or alias of the repository.",
+				RequiredForImport: true,
+			},
+			"service": identityschema.StringAttribute{
+				Description:       "The id or alias of the service.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *ServiceRepositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[ServiceRepositoryResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -186,6 +221,7 @@ func (r *ServiceRepositoryResource) Create(ctx context.Context, req resource.Cre
 
 	tflog.Trace(ctx, "created a service repository resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, stateModel.identity())...)
 }
 
 func (r *ServiceRepositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -202,6 +238,9 @@ func (r *ServiceRepositoryResource) Read(ctx context.Context, req resource.ReadR
 	} else {
 		service, err = r.client.GetServiceWithAlias(currentStateModel.ServiceAlias.ValueString())
 	}
+	if removeIfNotFound(ctx, resp, err, service != nil && service.Id != "") {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read service, got error: %s", err))
 		return
@@ -219,8 +258,7 @@ func (r *ServiceRepositoryResource) Read(ctx context.Context, req resource.ReadR
 			break
 		}
 	}
-	if serviceRepository == nil {
-		resp.State.RemoveResource(ctx)
+	if removeIfNotFound(ctx, resp, nil, serviceRepository != nil) {
 		return
 	}
 
@@ -228,6 +266,7 @@ func (r *ServiceRepositoryResource) Read(ctx context.Context, req resource.ReadR
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, verifiedStateModel.identity())...)
 }
 
 func extractServiceRepository(id string, serviceDependencies opslevel.ServiceDependenciesConnection) *opslevel.ServiceDependenciesEdge {
@@ -240,6 +279,10 @@ func extractServiceRepository(id string, serviceDependencies opslevel.ServiceDep
 }
 
 func (r *ServiceRepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[ServiceRepositoryResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -266,9 +309,14 @@ func (r *ServiceRepositoryResource) Update(ctx context.Context, req resource.Upd
 
 	tflog.Trace(ctx, "updated a service repository resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, stateModel.identity())...)
 }
 
 func (r *ServiceRepositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[ServiceRepositoryResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -287,11 +335,20 @@ func (r *ServiceRepositoryResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *ServiceRepositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	ids := strings.SplitN(req.ID, ":", 2)
+	importID := req.ID
+	if importID == "" {
+		identity := read[serviceRepositoryIdentityModel](ctx, &resp.Diagnostics, req.Identity)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		importID = identity.Service.ValueString() + ":" + identity.Repository.ValueString()
+	}
+
+	ids := strings.SplitN(importID, ":", 2)
 	if len(ids) != 2 {
 		resp.Diagnostics.AddError(
 			"Invalid format for given Import Id",
-			fmt.Sprintf("Id expected to be formatted as '<service-id-or-alias>:<repository-id-or-alias>'. Given '%s'", req.ID),
+			fmt.Sprintf("Id expected to be formatted as '<service-id-or-alias>:<repository-id-or-alias>'. Given '%s'", importID),
 		)
 		return
 	}
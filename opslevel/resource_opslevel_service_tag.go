@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -21,6 +22,8 @@ var _ resource.ResourceWithConfigure = &ServiceTagResource{}
 
 var _ resource.ResourceWithImportState = &ServiceTagResource{}
 
+var _ resource.ResourceWithIdentity = &ServiceTagResource{}
+
 type ServiceTagResource struct {
 	CommonResourceClient
 }
@@ -37,6 +40,19 @@ type ServiceTagResourceModel struct {
 	Value        types.String `tfsdk:"value"`
 }
 
+// serviceTagIdentityModel identifies a service tag by the id or alias of its service and its key
+type serviceTagIdentityModel struct {
+	Key     types.String `tfsdk:"key"`
+	Service types.String `tfsdk:"service"`
+}
+
+func (m ServiceTagResourceModel) identity() serviceTagIdentityModel {
+	return serviceTagIdentityModel{
+		Key:     m.Key,
+		Service: identifierValue(m.Service, m.ServiceAlias),
+	}
+}
+
 func NewServiceTagResourceModel(serviceTag opslevel.Tag) ServiceTagResourceModel {
 	serviceResourceModel := ServiceTagResourceModel{
 		Key:   RequiredStringValue(serviceTag.Key),
@@ -49,6 +65,8 @@ func NewServiceTagResourceModel(serviceTag opslevel.Tag) ServiceTagResourceModel
 
 func (serviceTagResource *ServiceTagResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_service_tag"
+	// the tag key can be updated in place
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (serviceTagResource *ServiceTagResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -96,7 +114,26 @@ func (serviceTagResource *ServiceTagResource) Schema(ctx context.Context, req re
 	}
 }
 
+func (serviceTagResource *ServiceTagResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"key": identityschema.StringAttribute{
+				Description:       "The tag's key.",
+				RequiredForImport: true,
+			},
+			"service": identityschema.StringAttribute{
+				Description:       "The id or alias of the service the tag belongs to.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (serviceTagResource *ServiceTagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if serviceTagResource.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	data := read[ServiceTagResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -133,6 +170,7 @@ func (serviceTagResource *ServiceTagResource) Create(ctx context.Context, req re
 	}
 	tflog.Trace(ctx, "created a service tag resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &createdServiceTagResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, createdServiceTagResourceModel.identity())...)
 }
 
 func (serviceTagResource *ServiceTagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -152,7 +190,10 @@ func (serviceTagResource *ServiceTagResource) Read(ctx context.Context, req reso
 		serviceIdentifier = data.ServiceAlias.ValueString()
 		service, err = serviceTagResource.client.GetServiceWithAlias(serviceIdentifier)
 	}
-	if err != nil || service == nil {
+	if removeIfNotFound(ctx, resp, err, service != nil && service.Id != "") {
+		return
+	}
+	if err != nil {
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("unable to read service (%s), got error: %s", serviceIdentifier, err))
 		return
 	}
@@ -167,8 +208,7 @@ func (serviceTagResource *ServiceTagResource) Read(ctx context.Context, req reso
 			break
 		}
 	}
-	if serviceTag == nil || serviceTag.Id == "" {
-		resp.State.RemoveResource(ctx)
+	if removeIfNotFound(ctx, resp, nil, serviceTag != nil && serviceTag.Id != "") {
 		return
 	}
 
@@ -180,9 +220,14 @@ func (serviceTagResource *ServiceTagResource) Read(ctx context.Context, req reso
 		readServiceResourceModel.ServiceAlias = OptionalStringValue(serviceIdentifier)
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &readServiceResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, readServiceResourceModel.identity())...)
 }
 
 func (serviceTagResource *ServiceTagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if serviceTagResource.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	data := read[ServiceTagResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -217,9 +262,14 @@ func (serviceTagResource *ServiceTagResource) Update(ctx context.Context, req re
 	}
 	tflog.Trace(ctx, "updated a service tag")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &updatedServiceTagResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, updatedServiceTagResourceModel.identity())...)
 }
 
 func (serviceTagResource *ServiceTagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if serviceTagResource.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	data := read[ServiceTagResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -234,6 +284,22 @@ func (serviceTagResource *ServiceTagResource) Delete(ctx context.Context, req re
 }
 
 func (serviceTagResource *ServiceTagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		identity := read[serviceTagIdentityModel](ctx, &resp.Diagnostics, req.Identity)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Read looks the tag up by its service and key, so they are all that is needed here
+		if opslevel.IsID(identity.Service.ValueString()) {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service"), identity.Service)...)
+		} else {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_alias"), identity.Service)...)
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), identity.Key)...)
+		return
+	}
+
 	if !isTagValid(req.ID) {
 		resp.Diagnostics.AddError(
 			"Invalid format for given Import Id",
@@ -0,0 +1,113 @@
+package opslevel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestServiceTagIdentity(t *testing.T) {
+	testCases := []struct {
+		name            string
+		model           ServiceTagResourceModel
+		expectedService string
+	}{
+		{
+			name: "service id",
+			model: ServiceTagResourceModel{
+				Key:          types.StringValue("env"),
+				Service:      types.StringValue("Z2lkOi8vb3BzbGV2ZWwvU2VydmljZS8x"),
+				ServiceAlias: types.StringNull(),
+			},
+			expectedService: "Z2lkOi8vb3BzbGV2ZWwvU2VydmljZS8x",
+		},
+		{
+			name: "service alias",
+			model: ServiceTagResourceModel{
+				Key:          types.StringValue("env"),
+				Service:      types.StringNull(),
+				ServiceAlias: types.StringValue("checkout"),
+			},
+			expectedService: "checkout",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			identity := tc.model.identity()
+			if identity.Service.ValueString() != tc.expectedService {
+				t.Errorf("expected service '%s', got '%s'", tc.expectedService, identity.Service.ValueString())
+			}
+			if identity.Key.ValueString() != "env" {
+				t.Errorf("expected key 'env', got '%s'", identity.Key.ValueString())
+			}
+		})
+	}
+}
+
+func TestServiceTagImportStateByIdentity(t *testing.T) {
+	ctx := context.Background()
+	serviceTagResource := &ServiceTagResource{}
+	schemaResp := &resource.SchemaResponse{}
+	serviceTagResource.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	identitySchemaResp := &resource.IdentitySchemaResponse{}
+	serviceTagResource.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, identitySchemaResp)
+	identityType := identitySchemaResp.IdentitySchema.Type().TerraformType(ctx)
+
+	testCases := []struct {
+		name          string
+		service       string
+		expectedId    string
+		expectedAlias string
+	}{
+		{
+			name:       "service id",
+			service:    "Z2lkOi8vb3BzbGV2ZWwvU2VydmljZS8x",
+			expectedId: "Z2lkOi8vb3BzbGV2ZWwvU2VydmljZS8x",
+		},
+		{
+			name:          "service alias",
+			service:       "checkout",
+			expectedAlias: "checkout",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := resource.ImportStateRequest{
+				Identity: &tfsdk.ResourceIdentity{
+					Schema: identitySchemaResp.IdentitySchema,
+					Raw: tftypes.NewValue(identityType, map[string]tftypes.Value{
+						"key":     tftypes.NewValue(tftypes.String, "env"),
+						"service": tftypes.NewValue(tftypes.String, tc.service),
+					}),
+				},
+			}
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+
+			serviceTagResource.ImportState(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			imported := read[ServiceTagResourceModel](ctx, &resp.Diagnostics, resp.State)
+			if imported.Key.ValueString() != "env" {
+				t.Errorf("expected key 'env', got '%s'", imported.Key.ValueString())
+			}
+			if imported.Service.ValueString() != tc.expectedId {
+				t.Errorf("expected service '%s', got '%s'", tc.expectedId, imported.Service.ValueString())
+			}
+			if imported.ServiceAlias.ValueString() != tc.expectedAlias {
+				t.Errorf("expected service_alias '%s', got '%s'", tc.expectedAlias, imported.ServiceAlias.ValueString())
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -21,6 +22,8 @@ var _ resource.ResourceWithConfigure = &ServiceToolResource{}
 
 var _ resource.ResourceWithImportState = &ServiceToolResource{}
 
+var _ resource.ResourceWithIdentity = &ServiceToolResource{}
+
 func NewServiceToolResource() resource.Resource {
 	return &ServiceToolResource{}
 }
@@ -41,6 +44,19 @@ type ServiceToolResourceModel struct {
 	Url          types.String `tfsdk:"url"`
 }
 
+// serviceToolIdentityModel identifies a service tool by its service and its id
+type serviceToolIdentityModel struct {
+	Id      types.String `tfsdk:"id"`
+	Service types.String `tfsdk:"service"`
+}
+
+func (m ServiceToolResourceModel) identity() serviceToolIdentityModel {
+	return serviceToolIdentityModel{
+		Id:      m.Id,
+		Service: identifierValue(m.Service, m.ServiceAlias),
+	}
+}
+
 func NewServiceToolResourceModel(ctx context.Context, serviceTool opslevel.Tool, planModel ServiceToolResourceModel) ServiceToolResourceModel {
 	stateModel := ServiceToolResourceModel{
 		Category:    RequiredStringValue(string(serviceTool.Category)),
@@ -125,7 +141,26 @@ func (r *ServiceToolResource) Schema(ctx context.Context, req resource.SchemaReq
 	}
 }
 
+func (r *ServiceToolResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the tool.",
+				RequiredForImport: true,
+			},
+			"service": identityschema.StringAttribute{
+				Description:       "The ID of the service the tool belongs to.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *ServiceToolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[ServiceToolResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -159,6 +194,7 @@ func (r *ServiceToolResource) Create(ctx context.Context, req resource.CreateReq
 
 	tflog.Trace(ctx, "created a service tool resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, stateModel.identity())...)
 }
 
 func (r *ServiceToolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -174,7 +210,10 @@ func (r *ServiceToolResource) Read(ctx context.Context, req resource.ReadRequest
 	} else {
 		service, err = r.client.GetServiceWithAlias(stateModel.ServiceAlias.ValueString())
 	}
-	if err != nil || service == nil || string(service.Id) == "" {
+	if removeIfNotFound(ctx, resp, err, service != nil && service.Id != "") {
+		return
+	}
+	if err != nil {
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read service, got error: %s", err))
 		return
 	}
@@ -192,8 +231,7 @@ func (r *ServiceToolResource) Read(ctx context.Context, req resource.ReadRequest
 			break
 		}
 	}
-	if serviceTool == nil || string(serviceTool.Id) == "" {
-		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("unable to find tool with id '%s' on service with id '%s'", id, service.Id))
+	if removeIfNotFound(ctx, resp, nil, serviceTool != nil && serviceTool.Id != "") {
 		return
 	}
 
@@ -201,9 +239,14 @@ func (r *ServiceToolResource) Read(ctx context.Context, req resource.ReadRequest
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, verifiedStateModel.identity())...)
 }
 
 func (r *ServiceToolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[ServiceToolResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -225,9 +268,14 @@ func (r *ServiceToolResource) Update(ctx context.Context, req resource.UpdateReq
 
 	tflog.Trace(ctx, "updated a service tool resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, stateModel.identity())...)
 }
 
 func (r *ServiceToolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[ServiceToolResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -241,15 +289,24 @@ func (r *ServiceToolResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *ServiceToolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	if !isTagValid(req.ID) {
+	importID := req.ID
+	if importID == "" {
+		identity := read[serviceToolIdentityModel](ctx, &resp.Diagnostics, req.Identity)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		importID = identity.Service.ValueString() + ":" + identity.Id.ValueString()
+	}
+
+	if !isTagValid(importID) {
 		resp.Diagnostics.AddError(
 			"Invalid format for given Import Id",
-			fmt.Sprintf("Id expected to be formatted as '<service-id>:<tool-id>'. Given '%s'", req.ID),
+			fmt.Sprintf("Id expected to be formatted as '<service-id>:<tool-id>'. Given '%s'", importID),
 		)
 		return
 	}
 
-	ids := strings.Split(req.ID, ":")
+	ids := strings.Split(importID, ":")
 	serviceId := ids[0]
 	toolId := ids[1]
 
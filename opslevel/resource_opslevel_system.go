@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -19,6 +18,8 @@ var _ resource.ResourceWithConfigure = &SystemResource{}
 
 var _ resource.ResourceWithImportState = &SystemResource{}
 
+var _ resource.ResourceWithIdentity = &SystemResource{}
+
 func NewSystemResource() resource.Resource {
 	return &SystemResource{}
 }
@@ -100,7 +101,15 @@ func (r *SystemResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+func (r *SystemResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *SystemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[SystemResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -114,12 +123,12 @@ func (r *SystemResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	teamIdentifier := planModel.Owner.ValueStringPointer()
 	if teamIdentifier != nil && !opslevel.IsID(*teamIdentifier) {
-		team, err := r.client.GetTeamWithAlias(*teamIdentifier)
+		teamId, err := r.cache.resolveTeamID(r.client, *teamIdentifier)
 		if err != nil {
 			resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read team, got error: %s", err))
 			return
 		}
-		*teamIdentifier = string(team.Id)
+		*teamIdentifier = string(teamId)
 	}
 	input.OwnerId = nullableID(teamIdentifier)
 
@@ -135,6 +144,7 @@ func (r *SystemResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	tflog.Trace(ctx, "created a system resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *SystemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -144,11 +154,10 @@ func (r *SystemResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	readSystem, err := r.client.GetSystem(stateModel.Id.ValueString())
+	if removeIfNotFound(ctx, resp, err, readSystem != nil && readSystem.Id != "") {
+		return
+	}
 	if err != nil {
-		if (readSystem == nil || readSystem.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read system, got error: %s", err))
 		return
 	}
@@ -156,9 +165,14 @@ func (r *SystemResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *SystemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[SystemResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	stateModel := read[SystemResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
@@ -178,12 +192,12 @@ func (r *SystemResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 	} else {
 		if !opslevel.IsID(*teamIdentifier) {
-			team, err := r.client.GetTeamWithAlias(*teamIdentifier)
+			teamId, err := r.cache.resolveTeamID(r.client, *teamIdentifier)
 			if err != nil {
 				resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read team, got error: %s", err))
 				return
 			}
-			*teamIdentifier = string(team.Id)
+			*teamIdentifier = string(teamId)
 		}
 		input.OwnerId = nullableID(teamIdentifier)
 	}
@@ -206,9 +220,14 @@ func (r *SystemResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	tflog.Trace(ctx, "updated a system resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &finalModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: finalModel.Id})...)
 }
 
 func (r *SystemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[SystemResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -222,5 +241,5 @@ func (r *SystemResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *SystemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateWithAlias(ctx, r.client, opslevel.AliasOwnerTypeEnumSystem, req, resp)
 }
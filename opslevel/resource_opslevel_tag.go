@@ -21,6 +21,8 @@ var _ resource.ResourceWithConfigure = &TagResource{}
 
 var _ resource.ResourceWithImportState = &TagResource{}
 
+var _ resource.ResourceWithIdentity = &TagResource{}
+
 func NewTagResource() resource.Resource {
 	return &TagResource{}
 }
@@ -101,7 +103,15 @@ func (r *TagResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 	}
 }
 
+func (r *TagResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[TagResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -130,6 +140,7 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 
 	tflog.Trace(ctx, "created a tag resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -141,12 +152,11 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	resourceId := stateModel.TargetResource.ValueString()
 	resourceType := opslevel.TaggableResource(stateModel.TargetType.ValueString())
 	data, err := r.client.GetTaggableResource(resourceType, resourceId)
+	// If the parent resource is gone, remove the tag from state
+	if removeIfNotFound(ctx, resp, err, err == nil) {
+		return
+	}
 	if err != nil {
-		// If the parent resource is gone, remove the tag from state
-		if opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		title, detail := formatOpslevelError("read tag", err)
 		resp.Diagnostics.AddError(title, detail)
 		return
@@ -157,16 +167,14 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		resp.Diagnostics.AddError(title, detail)
 		return
 	}
-	if tags == nil {
-		resp.State.RemoveResource(ctx)
+	if removeIfNotFound(ctx, resp, nil, tags != nil) {
 		return
 	}
 
 	id := stateModel.Id.ValueString()
 	tag, err := tags.GetTagById(*opslevel.NewID(id))
 	if err != nil {
-		if tag == nil || tag.Id == "" {
-			resp.State.RemoveResource(ctx)
+		if removeIfNotFound(ctx, resp, nil, tag != nil && tag.Id != "") {
 			return
 		}
 		title, detail := formatOpslevelError(
@@ -181,9 +189,14 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 
 	// Save updated planModel into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[TagResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -205,9 +218,14 @@ func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 
 	tflog.Trace(ctx, "updated a tag resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	data := read[TagResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -223,5 +241,5 @@ func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 }
 
 func (r *TagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
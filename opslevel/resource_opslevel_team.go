@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"slices"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,6 +22,8 @@ var _ resource.ResourceWithConfigure = &TeamResource{}
 
 var _ resource.ResourceWithImportState = &TeamResource{}
 
+var _ resource.ResourceWithIdentity = &TeamResource{}
+
 type TeamResource struct {
 	CommonResourceClient
 }
@@ -31,12 +34,13 @@ func NewTeamResource() resource.Resource {
 
 // TeamResourceModel describes the Team managed resource.
 type TeamResourceModel struct {
-	Aliases          types.Set    `tfsdk:"aliases"`
-	Id               types.String `tfsdk:"id"`
-	Member           []TeamMember `tfsdk:"member"`
-	Name             types.String `tfsdk:"name"`
-	Parent           types.String `tfsdk:"parent"`
-	Responsibilities types.String `tfsdk:"responsibilities"`
+	Aliases          types.Set      `tfsdk:"aliases"`
+	Id               types.String   `tfsdk:"id"`
+	Member           []TeamMember   `tfsdk:"member"`
+	Name             types.String   `tfsdk:"name"`
+	Parent           types.String   `tfsdk:"parent"`
+	Responsibilities types.String   `tfsdk:"responsibilities"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 type TeamMember struct {
@@ -56,6 +60,7 @@ func NewTeamResourceModel(ctx context.Context, team opslevel.Team, givenModel Te
 		Id:               ComputedStringValue(string(team.Id)),
 		Name:             RequiredStringValue(team.Name),
 		Responsibilities: StringValueFromResourceAndModelField(team.Responsibilities, givenModel.Responsibilities),
+		Timeouts:         givenModel.Timeouts,
 	}
 
 	if givenModel.Aliases.IsNull() {
@@ -124,16 +129,33 @@ func (teamResource *TeamResource) Schema(ctx context.Context, req resource.Schem
 					},
 				},
 			},
+			"timeouts": timeoutsBlock(ctx, reconcileTimeouts),
 		},
 	}
 }
 
+func (teamResource *TeamResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (teamResource *TeamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if teamResource.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
+	defer teamResource.cache.invalidate(lookupKindTeam)
+
 	planModel := read[TeamResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, client := teamResource.startOperation(ctx, planModel.Timeouts.Create, reconcileTimeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	teamCreateInput := opslevel.TeamCreateInput{
 		Name:             planModel.Name.ValueString(),
 		Responsibilities: nullable(planModel.Responsibilities.ValueStringPointer()),
@@ -151,7 +173,7 @@ func (teamResource *TeamResource) Create(ctx context.Context, req resource.Creat
 		teamCreateInput.ParentTeam = opslevel.NewIdentifier(planModel.Parent.ValueString())
 	}
 
-	team, err := teamResource.client.CreateTeam(teamCreateInput)
+	team, err := client.CreateTeam(teamCreateInput)
 	if err != nil || team == nil {
 		title, detail := formatOpslevelError("create team", err)
 		resp.Diagnostics.AddError(title, detail)
@@ -167,11 +189,11 @@ func (teamResource *TeamResource) Create(ctx context.Context, req resource.Creat
 		// add "unique identifiers" (OpsLevel created aliases) before reconciling.
 		// this ensures that we don't try to create an alias that already exists
 		aliases = append(aliases, team.UniqueIdentifiers()...)
-		if err = team.ReconcileAliases(teamResource.client, aliases); err != nil {
+		if err = team.ReconcileAliases(client, aliases); err != nil {
 			resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("unable to reconcile team aliases: '%s'\n%s", aliases, err))
 
 			// delete newly created team to avoid dupliate team creation on next 'terraform apply'
-			if err := teamResource.client.DeleteTeam(string(team.Id)); err != nil {
+			if err := client.DeleteTeam(string(team.Id)); err != nil {
 				resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("failed to delete incorrectly created team '%s' following aliases error:\n%s", team.Name, err))
 			}
 			return
@@ -190,6 +212,7 @@ func (teamResource *TeamResource) Create(ctx context.Context, req resource.Creat
 
 	tflog.Trace(ctx, "created a team resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &createdTeamResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: createdTeamResourceModel.Id})...)
 }
 
 func (teamResource *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -198,17 +221,21 @@ func (teamResource *TeamResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	team, err := teamResource.client.GetTeam(opslevel.ID(stateModel.Id.ValueString()))
+	ctx, cancel, client := teamResource.startOperation(ctx, stateModel.Timeouts.Read, reconcileTimeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	team, err := client.GetTeam(opslevel.ID(stateModel.Id.ValueString()))
+	if removeIfNotFound(ctx, resp, err, team != nil && team.Id != "") {
+		return
+	}
 	if err != nil {
-		if (team == nil || team.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		title, detail := formatOpslevelError("read team", err)
 		resp.Diagnostics.AddError(title, detail)
 		return
 	}
-	err = team.Hydrate(teamResource.client)
+	err = team.Hydrate(client)
 	if err != nil {
 		title, detail := formatOpslevelError("hydrate team", err)
 		resp.Diagnostics.AddError(title, detail)
@@ -224,15 +251,28 @@ func (teamResource *TeamResource) Read(ctx context.Context, req resource.ReadReq
 		readTeamResourceModel.Parent = StringValueFromResourceAndModelField(team.ParentTeam.Alias, stateModel.Parent)
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &readTeamResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: readTeamResourceModel.Id})...)
 }
 
 func (teamResource *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if teamResource.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
+	defer teamResource.cache.invalidate(lookupKindTeam)
+
 	planModel := read[TeamResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	stateModel := read[TeamResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, client := teamResource.startOperation(ctx, planModel.Timeouts.Update, reconcileTimeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	teamUpdateInput := opslevel.TeamUpdateInput{
 		Id:               opslevel.NewID(planModel.Id.ValueString()),
 		Name:             nullable(planModel.Name.ValueStringPointer()),
@@ -258,13 +298,13 @@ func (teamResource *TeamResource) Update(ctx context.Context, req resource.Updat
 	} else {
 		teamUpdateInput.ParentTeam = opslevel.NewIdentifier()
 	}
-	updatedTeam, err := teamResource.client.UpdateTeam(teamUpdateInput)
+	updatedTeam, err := client.UpdateTeam(teamUpdateInput)
 	if err != nil || updatedTeam == nil {
 		title, detail := formatOpslevelError("update team", err)
 		resp.Diagnostics.AddError(title, detail)
 		return
 	}
-	err = updatedTeam.Hydrate(teamResource.client)
+	err = updatedTeam.Hydrate(client)
 	if err != nil {
 		title, detail := formatOpslevelError("hydrate team", err)
 		resp.Diagnostics.AddError(title, detail)
@@ -283,7 +323,7 @@ func (teamResource *TeamResource) Update(ctx context.Context, req resource.Updat
 	uniqueIdentifiers := updatedTeam.UniqueIdentifiers()
 	for _, uniqueIdentifier := range uniqueIdentifiers {
 		if !slices.Contains(aliases, uniqueIdentifier) {
-			_ = teamResource.client.DeleteAlias(opslevel.AliasDeleteInput{
+			_ = client.DeleteAlias(opslevel.AliasDeleteInput{
 				Alias:     uniqueIdentifier,
 				OwnerType: opslevel.AliasOwnerTypeEnumTeam,
 			})
@@ -292,7 +332,7 @@ func (teamResource *TeamResource) Update(ctx context.Context, req resource.Updat
 	// add "unique identifiers" (OpsLevel created aliases) before reconciling.
 	// this ensures that we don't try to create an alias that already exists
 	aliases = append(aliases, uniqueIdentifiers...)
-	if err = updatedTeam.ReconcileAliases(teamResource.client, aliases); err != nil {
+	if err = updatedTeam.ReconcileAliases(client, aliases); err != nil {
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("unable to reconcile team aliases: '%s'\n%s", aliases, err))
 		return
 	}
@@ -307,15 +347,27 @@ func (teamResource *TeamResource) Update(ctx context.Context, req resource.Updat
 	}
 	tflog.Trace(ctx, "updated a team resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &updatedTeamResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: updatedTeamResourceModel.Id})...)
 }
 
 func (teamResource *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if teamResource.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
+	defer teamResource.cache.invalidate(lookupKindTeam)
+
 	data := read[TeamResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	err := teamResource.client.DeleteTeam(data.Id.ValueString())
+	ctx, cancel, client := teamResource.startOperation(ctx, data.Timeouts.Delete, reconcileTimeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := client.DeleteTeam(data.Id.ValueString())
 	if err != nil {
 		title, detail := formatOpslevelError("delete team", err)
 		resp.Diagnostics.AddError(title, detail)
@@ -325,7 +377,7 @@ func (teamResource *TeamResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (teamResource *TeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateWithAlias(ctx, teamResource.client, opslevel.AliasOwnerTypeEnumTeam, req, resp)
 }
 
 func getMembers(members []TeamMember) ([]opslevel.TeamMembershipUserInput, error) {
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -21,6 +22,8 @@ var _ resource.ResourceWithConfigure = &TeamContactResource{}
 
 var _ resource.ResourceWithImportState = &TeamContactResource{}
 
+var _ resource.ResourceWithIdentity = &TeamContactResource{}
+
 type TeamContactResource struct {
 	CommonResourceClient
 }
@@ -37,6 +40,19 @@ type TeamContactResourceModel struct {
 	Value types.String `tfsdk:"value"`
 }
 
+// teamContactIdentityModel identifies a team contact by its team and its id
+type teamContactIdentityModel struct {
+	Id   types.String `tfsdk:"id"`
+	Team types.String `tfsdk:"team"`
+}
+
+func (m TeamContactResourceModel) identity() teamContactIdentityModel {
+	return teamContactIdentityModel{
+		Id:   m.Id,
+		Team: m.Team,
+	}
+}
+
 func NewTeamContactResourceModel(teamContact opslevel.Contact) TeamContactResourceModel {
 	teamResourceModel := TeamContactResourceModel{
 		Id:    RequiredStringValue(string(teamContact.Id)),
@@ -49,6 +65,8 @@ func NewTeamContactResourceModel(teamContact opslevel.Contact) TeamContactResour
 
 func (teamContactResource *TeamContactResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_team_contact"
+	// a contact can be moved to another team in place
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (teamContactResource *TeamContactResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -89,7 +107,26 @@ func (teamContactResource *TeamContactResource) Schema(ctx context.Context, req
 	}
 }
 
+func (teamContactResource *TeamContactResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the contact.",
+				RequiredForImport: true,
+			},
+			"team": identityschema.StringAttribute{
+				Description:       "The id or alias of the team the contact belongs to.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (teamContactResource *TeamContactResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if teamContactResource.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	data := read[TeamContactResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -113,6 +150,7 @@ func (teamContactResource *TeamContactResource) Create(ctx context.Context, req
 	createdTeamContactModel.Team = RequiredStringValue(teamIdentifier)
 	tflog.Trace(ctx, "created a team contact resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &createdTeamContactModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, createdTeamContactModel.identity())...)
 }
 
 func (teamContactResource *TeamContactResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -125,13 +163,14 @@ func (teamContactResource *TeamContactResource) Read(ctx context.Context, req re
 	contactID := data.Id.ValueString()
 
 	var team *opslevel.Team
-	var err error
-	if opslevel.IsID(teamIdentifier) {
-		team, err = teamContactResource.client.GetTeam(opslevel.ID(teamIdentifier))
-	} else {
-		team, err = teamContactResource.client.GetTeamWithAlias(teamIdentifier)
+	teamId, err := teamContactResource.cache.resolveTeamID(teamContactResource.client, teamIdentifier)
+	if err == nil {
+		team, err = teamContactResource.client.GetTeam(teamId)
 	}
-	if err != nil || team == nil {
+	if removeIfNotFound(ctx, resp, err, team != nil && team.Id != "") {
+		return
+	}
+	if err != nil {
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("unable to read team (%s), got error: %s", teamIdentifier, err))
 		return
 	}
@@ -147,17 +186,21 @@ func (teamContactResource *TeamContactResource) Read(ctx context.Context, req re
 			break
 		}
 	}
-	if teamContact == nil || teamContact.Id == "" {
-		resp.State.RemoveResource(ctx)
+	if removeIfNotFound(ctx, resp, nil, teamContact != nil && teamContact.Id != "") {
 		return
 	}
 
 	readTeamContactResourceModel := NewTeamContactResourceModel(*teamContact)
 	readTeamContactResourceModel.Team = RequiredStringValue(teamIdentifier)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &readTeamContactResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, readTeamContactResourceModel.identity())...)
 }
 
 func (teamContactResource *TeamContactResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if teamContactResource.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	data := read[TeamContactResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -182,9 +225,14 @@ func (teamContactResource *TeamContactResource) Update(ctx context.Context, req
 	updatedTeamContactResourceModel.Team = RequiredStringValue(teamIdentifier)
 	tflog.Trace(ctx, "updated a team contact resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &updatedTeamContactResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, updatedTeamContactResourceModel.identity())...)
 }
 
 func (teamContactResource *TeamContactResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if teamContactResource.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	data := read[TeamContactResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -201,6 +249,18 @@ func (teamContactResource *TeamContactResource) Delete(ctx context.Context, req
 }
 
 func (teamContactResource *TeamContactResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		identity := read[teamContactIdentityModel](ctx, &resp.Diagnostics, req.Identity)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Read looks the contact up by its team and id, so they are all that is needed here
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.Id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team"), identity.Team)...)
+		return
+	}
+
 	if !isTagValid(req.ID) {
 		resp.Diagnostics.AddError(
 			"Invalid format for given Import Id",
@@ -20,6 +20,7 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = &TeamPropertyDefinitionResource{}
 	_ resource.ResourceWithImportState = &TeamPropertyDefinitionResource{}
+	_ resource.ResourceWithIdentity    = &TeamPropertyDefinitionResource{}
 )
 
 type TeamPropertyDefinitionResource struct {
@@ -99,7 +100,15 @@ func (r *TeamPropertyDefinitionResource) Schema(ctx context.Context, req resourc
 	}
 }
 
+func (r *TeamPropertyDefinitionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *TeamPropertyDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[TeamPropertyDefinitionResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -127,6 +136,7 @@ func (r *TeamPropertyDefinitionResource) Create(ctx context.Context, req resourc
 	stateModel := NewTeamPropertyDefinitionResourceModel(*definition, planModel)
 	tflog.Trace(ctx, fmt.Sprintf("created a team property definition resource with id '%s'", definition.Id))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *TeamPropertyDefinitionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -137,11 +147,10 @@ func (r *TeamPropertyDefinitionResource) Read(ctx context.Context, req resource.
 
 	id := stateModel.Id.ValueString()
 	definition, err := r.client.GetTeamPropertyDefinition(id)
+	if removeIfNotFound(ctx, resp, err, definition != nil && definition.Id != "") {
+		return
+	}
 	if err != nil {
-		if (definition == nil || definition.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("unable to read team property definition with id '%s', got error: %s", id, err))
 		return
 	}
@@ -149,9 +158,14 @@ func (r *TeamPropertyDefinitionResource) Read(ctx context.Context, req resource.
 	verifiedStateModel := NewTeamPropertyDefinitionResourceModel(*definition, stateModel)
 	tflog.Trace(ctx, fmt.Sprintf("read a team property definition resource with id '%s'", id))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *TeamPropertyDefinitionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[TeamPropertyDefinitionResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -180,9 +194,14 @@ func (r *TeamPropertyDefinitionResource) Update(ctx context.Context, req resourc
 	stateModel := NewTeamPropertyDefinitionResourceModel(*definition, planModel)
 	tflog.Trace(ctx, fmt.Sprintf("updated a team property definition resource with id '%s'", id))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *TeamPropertyDefinitionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[TeamPropertyDefinitionResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -226,5 +245,5 @@ func (r *TeamPropertyDefinitionResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *TeamPropertyDefinitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
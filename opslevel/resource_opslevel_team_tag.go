@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -28,6 +29,8 @@ var _ resource.ResourceWithConfigure = &TeamTagResource{}
 
 var _ resource.ResourceWithImportState = &TeamTagResource{}
 
+var _ resource.ResourceWithIdentity = &TeamTagResource{}
+
 type TeamTagResource struct {
 	CommonResourceClient
 }
@@ -44,6 +47,19 @@ type TeamTagResourceModel struct {
 	Value     types.String `tfsdk:"value"`
 }
 
+// teamTagIdentityModel identifies a team tag by the id or alias of its team and its key
+type teamTagIdentityModel struct {
+	Key  types.String `tfsdk:"key"`
+	Team types.String `tfsdk:"team"`
+}
+
+func (m TeamTagResourceModel) identity() teamTagIdentityModel {
+	return teamTagIdentityModel{
+		Key:  m.Key,
+		Team: identifierValue(m.Team, m.TeamAlias),
+	}
+}
+
 func NewTeamTagResourceModel(teamTag opslevel.Tag) TeamTagResourceModel {
 	teamResourceModel := TeamTagResourceModel{
 		Key:   RequiredStringValue(teamTag.Key),
@@ -56,6 +72,8 @@ func NewTeamTagResourceModel(teamTag opslevel.Tag) TeamTagResourceModel {
 
 func (teamTagResource *TeamTagResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_team_tag"
+	// the tag key can be updated in place
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (teamTagResource *TeamTagResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -103,7 +121,26 @@ func (teamTagResource *TeamTagResource) Schema(ctx context.Context, req resource
 	}
 }
 
+func (teamTagResource *TeamTagResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"key": identityschema.StringAttribute{
+				Description:       "The tag's key.",
+				RequiredForImport: true,
+			},
+			"team": identityschema.StringAttribute{
+				Description:       "The id or alias of the team the tag belongs to.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (teamTagResource *TeamTagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if teamTagResource.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	data := read[TeamTagResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -140,6 +177,7 @@ func (teamTagResource *TeamTagResource) Create(ctx context.Context, req resource
 	}
 	tflog.Trace(ctx, "created a team tag resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &createdTeamTagResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, createdTeamTagResourceModel.identity())...)
 }
 
 func (teamTagResource *TeamTagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -149,17 +187,19 @@ func (teamTagResource *TeamTagResource) Read(ctx context.Context, req resource.R
 	}
 
 	// use either the team ID or alias based on what is used in the config
-	var teamIdentifier string
-	var team *opslevel.Team
-	var err error
-	if data.Team.ValueString() != "" {
-		teamIdentifier = data.Team.ValueString()
-		team, err = teamTagResource.client.GetTeam(opslevel.ID(teamIdentifier))
-	} else {
+	teamIdentifier := data.Team.ValueString()
+	if teamIdentifier == "" {
 		teamIdentifier = data.TeamAlias.ValueString()
-		team, err = teamTagResource.client.GetTeamWithAlias(teamIdentifier)
 	}
-	if err != nil || team == nil {
+	var team *opslevel.Team
+	teamId, err := teamTagResource.cache.resolveTeamID(teamTagResource.client, teamIdentifier)
+	if err == nil {
+		team, err = teamTagResource.client.GetTeam(teamId)
+	}
+	if removeIfNotFound(ctx, resp, err, team != nil && team.Id != "") {
+		return
+	}
+	if err != nil {
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("unable to read team (%s), got error: %s", teamIdentifier, err))
 		return
 	}
@@ -174,8 +214,7 @@ func (teamTagResource *TeamTagResource) Read(ctx context.Context, req resource.R
 			break
 		}
 	}
-	if teamTag == nil || teamTag.Id == "" {
-		resp.State.RemoveResource(ctx)
+	if removeIfNotFound(ctx, resp, nil, teamTag != nil && teamTag.Id != "") {
 		return
 	}
 
@@ -187,9 +226,14 @@ func (teamTagResource *TeamTagResource) Read(ctx context.Context, req resource.R
 		readTeamResourceModel.TeamAlias = OptionalStringValue(teamIdentifier)
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &readTeamResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, readTeamResourceModel.identity())...)
 }
 
 func (teamTagResource *TeamTagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if teamTagResource.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	data := read[TeamTagResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -224,9 +268,14 @@ func (teamTagResource *TeamTagResource) Update(ctx context.Context, req resource
 	}
 	tflog.Trace(ctx, "updated a team tag")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &updatedTeamTagResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, updatedTeamTagResourceModel.identity())...)
 }
 
 func (teamTagResource *TeamTagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if teamTagResource.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	data := read[TeamTagResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -241,6 +290,22 @@ func (teamTagResource *TeamTagResource) Delete(ctx context.Context, req resource
 }
 
 func (teamTagResource *TeamTagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		identity := read[teamTagIdentityModel](ctx, &resp.Diagnostics, req.Identity)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Read looks the tag up by its team and key, so they are all that is needed here
+		if opslevel.IsID(identity.Team.ValueString()) {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team"), identity.Team)...)
+		} else {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_alias"), identity.Team)...)
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), identity.Key)...)
+		return
+	}
+
 	if !isTagValid(req.ID) {
 		resp.Diagnostics.AddError(
 			"Invalid format for given Import Id",
@@ -27,6 +27,8 @@ var _ resource.ResourceWithConfigure = &TriggerDefinitionResource{}
 
 var _ resource.ResourceWithImportState = &TriggerDefinitionResource{}
 
+var _ resource.ResourceWithIdentity = &TriggerDefinitionResource{}
+
 func NewTriggerDefinitionResource() resource.Resource {
 	return &TriggerDefinitionResource{}
 }
@@ -205,7 +207,15 @@ func (r *TriggerDefinitionResource) Schema(ctx context.Context, req resource.Sch
 	}
 }
 
+func (r *TriggerDefinitionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *TriggerDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[TriggerDefinitionResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -256,6 +266,7 @@ func (r *TriggerDefinitionResource) Create(ctx context.Context, req resource.Cre
 
 	tflog.Trace(ctx, "created a trigger definition resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModel.Id})...)
 }
 
 func (r *TriggerDefinitionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -265,11 +276,10 @@ func (r *TriggerDefinitionResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	triggerDefinition, err := r.client.GetTriggerDefinition(stateModel.Id.ValueString())
+	if removeIfNotFound(ctx, resp, err, triggerDefinition != nil && triggerDefinition.Id != "") {
+		return
+	}
 	if err != nil {
-		if (triggerDefinition == nil || triggerDefinition.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read trigger definition, got error: %s", err))
 		return
 	}
@@ -279,9 +289,14 @@ func (r *TriggerDefinitionResource) Read(ctx context.Context, req resource.ReadR
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *TriggerDefinitionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[TriggerDefinitionResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	stateModel := read[TriggerDefinitionResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
@@ -329,9 +344,14 @@ func (r *TriggerDefinitionResource) Update(ctx context.Context, req resource.Upd
 	resp.Diagnostics.Append(diags...)
 	tflog.Trace(ctx, "updated a trigger definition resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModelFinal)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModelFinal.Id})...)
 }
 
 func (r *TriggerDefinitionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	stateModel := read[TriggerDefinitionResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -346,7 +366,7 @@ func (r *TriggerDefinitionResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *TriggerDefinitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func getApprovalConfig(ctx context.Context, planModel TriggerDefinitionResourceModel) (opslevel.ApprovalConfigInput, diag.Diagnostics) {
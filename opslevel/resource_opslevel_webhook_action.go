@@ -22,6 +22,8 @@ var _ resource.ResourceWithConfigure = &WebhookActionResource{}
 
 var _ resource.ResourceWithImportState = &WebhookActionResource{}
 
+var _ resource.ResourceWithIdentity = &WebhookActionResource{}
+
 func NewWebhookActionResource() resource.Resource {
 	return &WebhookActionResource{}
 }
@@ -114,7 +116,15 @@ func (r *WebhookActionResource) Schema(ctx context.Context, req resource.SchemaR
 	}
 }
 
+func (r *WebhookActionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
 func (r *WebhookActionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "create") {
+		return
+	}
+
 	planModel := read[WebhookActionResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	if resp.Diagnostics.HasError() {
 		return
@@ -144,6 +154,7 @@ func (r *WebhookActionResource) Create(ctx context.Context, req resource.CreateR
 
 	tflog.Trace(ctx, "created a webhook action resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &createdWebhookActionResourceModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: createdWebhookActionResourceModel.Id})...)
 }
 
 func (r *WebhookActionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -153,11 +164,10 @@ func (r *WebhookActionResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	webhookAction, err := r.client.GetCustomAction(stateModel.Id.ValueString())
+	if removeIfNotFound(ctx, resp, err, webhookAction != nil && webhookAction.CustomActionsWebhookAction.Id != "") {
+		return
+	}
 	if err != nil {
-		if (webhookAction == nil || webhookAction.CustomActionsWebhookAction.Id == "") && opslevel.IsOpsLevelApiError(err) {
-			resp.State.RemoveResource(ctx)
-			return
-		}
 		resp.Diagnostics.AddError("opslevel client error", fmt.Sprintf("Unable to read webhookAction, got error: %s", err))
 		return
 	}
@@ -166,9 +176,14 @@ func (r *WebhookActionResource) Read(ctx context.Context, req resource.ReadReque
 	// Save updated data into Terraform state
 	tflog.Trace(ctx, "read a webhook action resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &verifiedStateModel)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: verifiedStateModel.Id})...)
 }
 
 func (r *WebhookActionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "update") {
+		return
+	}
+
 	planModel := read[WebhookActionResourceModel](ctx, &resp.Diagnostics, req.Plan)
 	stateModel := read[WebhookActionResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
@@ -205,9 +220,14 @@ func (r *WebhookActionResource) Update(ctx context.Context, req resource.UpdateR
 
 	tflog.Trace(ctx, "updated a webhook action resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModelFinal)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{Id: stateModelFinal.Id})...)
 }
 
 func (r *WebhookActionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.failIfReadOnly(&resp.Diagnostics, "delete") {
+		return
+	}
+
 	data := read[WebhookActionResourceModel](ctx, &resp.Diagnostics, req.State)
 	if resp.Diagnostics.HasError() {
 		return
@@ -221,5 +241,5 @@ func (r *WebhookActionResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *WebhookActionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
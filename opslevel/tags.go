@@ -0,0 +1,197 @@
+package opslevel
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+// tagConfig holds the provider level tag settings applied to every resource that reconciles a full set of tags.
+// A nil *tagConfig is valid and behaves as if no tag settings were configured.
+type tagConfig struct {
+	defaults          []opslevel.Tag
+	ignoreKeys        []string
+	ignoreKeyPrefixes []string
+}
+
+// defaultTagsModel describes the provider `default_tags` block
+type defaultTagsModel struct {
+	Tags types.Set `tfsdk:"tags"`
+}
+
+// ignoreTagsModel describes the provider `ignore_tags` block
+type ignoreTagsModel struct {
+	Keys        types.Set `tfsdk:"keys"`
+	KeyPrefixes types.Set `tfsdk:"key_prefixes"`
+}
+
+func newTagConfig(ctx context.Context, defaultTags *defaultTagsModel, ignoreTags *ignoreTagsModel) (*tagConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	config := &tagConfig{}
+
+	if defaultTags != nil {
+		defaults, tagDiags := TagSetValueToTagSlice(ctx, defaultTags.Tags)
+		diags.Append(tagDiags...)
+		config.defaults = defaults
+	}
+
+	if ignoreTags != nil {
+		keys, keyDiags := SetValueToStringSlice(ctx, ignoreTags.Keys)
+		diags.Append(keyDiags...)
+		prefixes, prefixDiags := SetValueToStringSlice(ctx, ignoreTags.KeyPrefixes)
+		diags.Append(prefixDiags...)
+		config.ignoreKeys = keys
+		config.ignoreKeyPrefixes = prefixes
+	}
+
+	return config, diags
+}
+
+// isIgnored reports whether a tag is managed outside of Terraform, such as by an integration
+func (c *tagConfig) isIgnored(tag opslevel.Tag) bool {
+	if c == nil {
+		return false
+	}
+	if slices.Contains(c.ignoreKeys, tag.Key) {
+		return true
+	}
+	return slices.ContainsFunc(c.ignoreKeyPrefixes, func(prefix string) bool {
+		return strings.HasPrefix(tag.Key, prefix)
+	})
+}
+
+// withoutIgnored returns the tags that are not matched by ignore_tags
+func (c *tagConfig) withoutIgnored(tags []opslevel.Tag) []opslevel.Tag {
+	output := []opslevel.Tag{}
+	for _, tag := range tags {
+		if !c.isIgnored(tag) {
+			output = append(output, tag)
+		}
+	}
+	return output
+}
+
+// reconcile sets the tags on a resource to the given tags merged with default_tags.
+// When authoritative, every other tag is deleted, as `tags` then describes the full set of tags.
+// Otherwise only the keys of the given and default tags are managed and every other tag is kept,
+// as it may come from an opslevel_service_tag resource or be added outside of Terraform.
+// Tags matched by ignore_tags are always kept as they are.
+func (c *tagConfig) reconcile(client *opslevel.Client, resource opslevel.TaggableResourceInterface, tags []opslevel.Tag, authoritative bool) error {
+	desired := c.merge(c.withoutIgnored(tags))
+	if authoritative && (c == nil || (len(c.ignoreKeys) == 0 && len(c.ignoreKeyPrefixes) == 0)) {
+		return client.ReconcileTags(resource, desired)
+	}
+	if !authoritative && len(desired) == 0 {
+		return nil
+	}
+
+	current, err := resource.GetTags(client, nil)
+	if err != nil {
+		return err
+	}
+	return client.ReconcileTags(resource, c.desiredTags(current.Nodes, desired, authoritative))
+}
+
+// desiredTags returns the tags a resource should have once the managed tags are applied to its current tags
+func (c *tagConfig) desiredTags(current []opslevel.Tag, managed []opslevel.Tag, authoritative bool) []opslevel.Tag {
+	desired := slices.Clone(managed)
+	for _, tag := range current {
+		isManagedKey := slices.ContainsFunc(managed, func(managedTag opslevel.Tag) bool { return managedTag.Key == tag.Key })
+		if c.isIgnored(tag) || (!authoritative && !isManagedKey) {
+			desired = append(desired, tag)
+		}
+	}
+	return desired
+}
+
+func (c *tagConfig) hasDefaults() bool {
+	return c != nil && len(c.defaults) > 0
+}
+
+// merge returns the resource tags plus every default tag whose key the resource does not set itself
+func (c *tagConfig) merge(tags []opslevel.Tag) []opslevel.Tag {
+	merged := slices.Clone(tags)
+	if c == nil {
+		return merged
+	}
+
+	for _, defaultTag := range c.defaults {
+		if !slices.ContainsFunc(tags, func(tag opslevel.Tag) bool { return tag.Key == defaultTag.Key }) {
+			merged = append(merged, defaultTag)
+		}
+	}
+	return merged
+}
+
+// isDefault reports whether a tag read from the API is one of the default tags
+func (c *tagConfig) isDefault(tag opslevel.Tag) bool {
+	return c != nil && slices.ContainsFunc(c.defaults, func(defaultTag opslevel.Tag) bool {
+		return defaultTag.Key == tag.Key && defaultTag.Value == tag.Value
+	})
+}
+
+// resourceTags splits the tags read from the API into the `tags` and `tags_all` attribute values.
+// Tags that only exist because of default_tags are left out of `tags` so they don't show up as drift,
+// and tags matched by ignore_tags are left out of both.
+func (c *tagConfig) resourceTags(ctx context.Context, apiTags []opslevel.Tag, givenTags types.Set) (types.Set, types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if givenTags.IsNull() && !c.hasDefaults() {
+		return types.SetNull(types.StringType), types.SetNull(types.StringType), diags
+	}
+	apiTags = c.withoutIgnored(apiTags)
+
+	given, _ := SetValueToStringSlice(ctx, givenTags)
+	tags := []string{}
+	for _, tag := range apiTags {
+		if c.isDefault(tag) && !slices.Contains(given, flattenTag(tag)) {
+			continue
+		}
+		tags = append(tags, flattenTag(tag))
+	}
+
+	tagsAll, setDiags := types.SetValueFrom(ctx, types.StringType, flattenTagArray(apiTags))
+	diags.Append(setDiags...)
+	if givenTags.IsNull() {
+		return types.SetNull(types.StringType), tagsAll, diags
+	}
+
+	tagsValue, setDiags := types.SetValueFrom(ctx, types.StringType, tags)
+	diags.Append(setDiags...)
+	return tagsValue, tagsAll, diags
+}
+
+// plannedTagsAll returns the `tags_all` value a resource will have once its given tags are merged with default_tags.
+// Without given tags only the default keys are managed and every other tag on the resource is kept,
+// so those are taken from the prior `tags_all`, or left unknown when there is none to take them from.
+func (c *tagConfig) plannedTagsAll(ctx context.Context, givenTags types.Set, priorTagsAll types.Set) (types.Set, diag.Diagnostics) {
+	if givenTags.IsUnknown() {
+		return types.SetUnknown(types.StringType), nil
+	}
+	if givenTags.IsNull() && !c.hasDefaults() {
+		return types.SetNull(types.StringType), nil
+	}
+
+	var planned []opslevel.Tag
+	if givenTags.IsNull() {
+		if priorTagsAll.IsNull() || priorTagsAll.IsUnknown() {
+			return types.SetUnknown(types.StringType), nil
+		}
+		prior, diags := TagSetValueToTagSlice(ctx, priorTagsAll)
+		if diags.HasError() {
+			return types.SetUnknown(types.StringType), diags
+		}
+		planned = c.withoutIgnored(c.desiredTags(prior, c.merge(nil), false))
+	} else {
+		tags, diags := TagSetValueToTagSlice(ctx, givenTags)
+		if diags.HasError() {
+			return types.SetUnknown(types.StringType), diags
+		}
+		planned = c.merge(c.withoutIgnored(tags))
+	}
+
+	return types.SetValueFrom(ctx, types.StringType, flattenTagArray(planned))
+}
@@ -0,0 +1,214 @@
+package opslevel
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+func TestTagConfigMerge(t *testing.T) {
+	config := &tagConfig{defaults: []opslevel.Tag{
+		{Key: "managed-by", Value: "terraform"},
+		{Key: "repo", Value: "infra"},
+	}}
+
+	testCases := []struct {
+		name     string
+		tags     []opslevel.Tag
+		expected []string
+	}{
+		{name: "no resource tags", tags: nil, expected: []string{"managed-by:terraform", "repo:infra"}},
+		{name: "resource tag added", tags: []opslevel.Tag{{Key: "tier", Value: "1"}}, expected: []string{"tier:1", "managed-by:terraform", "repo:infra"}},
+		{name: "resource tag wins on conflict", tags: []opslevel.Tag{{Key: "repo", Value: "service"}}, expected: []string{"repo:service", "managed-by:terraform"}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			merged := flattenTagArray(config.merge(testCase.tags))
+			if !slices.Equal(merged, testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, merged)
+			}
+		})
+	}
+}
+
+func TestTagConfigNilMerge(t *testing.T) {
+	var config *tagConfig
+	merged := config.merge([]opslevel.Tag{{Key: "tier", Value: "1"}})
+	if len(merged) != 1 || flattenTag(merged[0]) != "tier:1" {
+		t.Errorf("expected only the resource tag, got %v", flattenTagArray(merged))
+	}
+}
+
+func TestTagConfigResourceTagsHidesDefaults(t *testing.T) {
+	ctx := context.Background()
+	config := &tagConfig{defaults: []opslevel.Tag{{Key: "managed-by", Value: "terraform"}}}
+	apiTags := []opslevel.Tag{{Key: "managed-by", Value: "terraform"}, {Key: "tier", Value: "1"}}
+	givenTags, _ := types.SetValueFrom(ctx, types.StringType, []string{"tier:1"})
+
+	tags, tagsAll, diags := config.resourceTags(ctx, apiTags, givenTags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !tags.Equal(givenTags) {
+		t.Errorf("expected tags to exclude defaults, got %s", tags)
+	}
+	if len(tagsAll.Elements()) != 2 {
+		t.Errorf("expected tags_all to include defaults, got %s", tagsAll)
+	}
+}
+
+func TestTagConfigIsIgnored(t *testing.T) {
+	config := &tagConfig{
+		ignoreKeys:        []string{"k8s"},
+		ignoreKeyPrefixes: []string{"aws_", "azure_"},
+	}
+
+	testCases := []struct {
+		name     string
+		tag      opslevel.Tag
+		expected bool
+	}{
+		{name: "exact key", tag: opslevel.Tag{Key: "k8s", Value: "prod"}, expected: true},
+		{name: "key prefix", tag: opslevel.Tag{Key: "aws_region", Value: "us-east-1"}, expected: true},
+		{name: "other key prefix", tag: opslevel.Tag{Key: "azure_subscription", Value: "main"}, expected: true},
+		{name: "key only shares a prefix with an ignored key", tag: opslevel.Tag{Key: "k8s-cluster", Value: "main"}, expected: false},
+		{name: "unrelated key", tag: opslevel.Tag{Key: "tier", Value: "1"}, expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if ignored := config.isIgnored(testCase.tag); ignored != testCase.expected {
+				t.Errorf("expected isIgnored to be %v, got %v", testCase.expected, ignored)
+			}
+		})
+	}
+}
+
+func TestTagConfigDesiredTags(t *testing.T) {
+	config := &tagConfig{
+		defaults:          []opslevel.Tag{{Key: "managed-by", Value: "terraform"}},
+		ignoreKeyPrefixes: []string{"aws_"},
+	}
+	current := []opslevel.Tag{
+		{Key: "managed-by", Value: "console"},
+		{Key: "tier", Value: "2"},
+		{Key: "owner", Value: "service-tag-resource"},
+		{Key: "aws_region", Value: "us-east-1"},
+	}
+
+	testCases := []struct {
+		name          string
+		tags          []opslevel.Tag
+		authoritative bool
+		expected      []string
+	}{
+		{
+			name:     "only default tags keeps every unmanaged tag",
+			expected: []string{"managed-by:terraform", "tier:2", "owner:service-tag-resource", "aws_region:us-east-1"},
+		},
+		{
+			name:     "given tags replace the values of their keys",
+			tags:     []opslevel.Tag{{Key: "tier", Value: "1"}},
+			expected: []string{"tier:1", "managed-by:terraform", "owner:service-tag-resource", "aws_region:us-east-1"},
+		},
+		{
+			name:          "authoritative tags delete every other tag except ignored ones",
+			tags:          []opslevel.Tag{{Key: "tier", Value: "1"}},
+			authoritative: true,
+			expected:      []string{"tier:1", "managed-by:terraform", "aws_region:us-east-1"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			managed := config.merge(config.withoutIgnored(testCase.tags))
+			desired := flattenTagArray(config.desiredTags(current, managed, testCase.authoritative))
+			if !slices.Equal(desired, testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, desired)
+			}
+		})
+	}
+}
+
+func TestTagConfigPlannedTagsAll(t *testing.T) {
+	ctx := context.Background()
+	config := &tagConfig{
+		defaults:          []opslevel.Tag{{Key: "managed-by", Value: "terraform"}},
+		ignoreKeyPrefixes: []string{"aws_"},
+	}
+	// the service already has a tag from an opslevel_service_tag resource and one from an integration
+	apiTags := []opslevel.Tag{
+		{Key: "managed-by", Value: "console"},
+		{Key: "owner", Value: "service-tag-resource"},
+		{Key: "aws_region", Value: "us-east-1"},
+	}
+	stringSet := func(values ...string) types.Set {
+		set, _ := types.SetValueFrom(ctx, types.StringType, values)
+		return set
+	}
+
+	testCases := []struct {
+		name         string
+		givenTags    types.Set
+		priorTagsAll types.Set
+		expected     types.Set
+	}{
+		{
+			name:         "tags unset keeps the other tags of the service",
+			givenTags:    types.SetNull(types.StringType),
+			priorTagsAll: stringSet("managed-by:console", "owner:service-tag-resource"),
+			expected:     stringSet("managed-by:terraform", "owner:service-tag-resource"),
+		},
+		{
+			name:         "tags unset on a new service",
+			givenTags:    types.SetNull(types.StringType),
+			priorTagsAll: types.SetNull(types.StringType),
+			expected:     types.SetUnknown(types.StringType),
+		},
+		{
+			name:         "given tags are the full set",
+			givenTags:    stringSet("tier:1"),
+			priorTagsAll: stringSet("managed-by:console", "owner:service-tag-resource"),
+			expected:     stringSet("tier:1", "managed-by:terraform"),
+		},
+		{
+			name:         "unknown given tags",
+			givenTags:    types.SetUnknown(types.StringType),
+			priorTagsAll: stringSet("owner:service-tag-resource"),
+			expected:     types.SetUnknown(types.StringType),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			planned, diags := config.plannedTagsAll(ctx, testCase.givenTags, testCase.priorTagsAll)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !planned.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, planned)
+			}
+		})
+	}
+
+	// what Read stores after the apply must match the plan, or Terraform reports an inconsistent result
+	t.Run("plan matches the tags read after apply", func(t *testing.T) {
+		givenTags := types.SetNull(types.StringType)
+		_, priorTagsAll, diags := config.resourceTags(ctx, apiTags, givenTags)
+		planned, plannedDiags := config.plannedTagsAll(ctx, givenTags, priorTagsAll)
+		diags.Append(plannedDiags...)
+		appliedTags := config.desiredTags(apiTags, config.merge(nil), false)
+		_, readTagsAll, readDiags := config.resourceTags(ctx, appliedTags, givenTags)
+		diags.Append(readDiags...)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if !planned.Equal(readTagsAll) {
+			t.Errorf("planned tags_all %s, but read %s after apply", planned, readTagsAll)
+		}
+	})
+}
@@ -0,0 +1,125 @@
+package opslevel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+const timeoutsPollInterval = 2 * time.Second
+
+// operationTimeouts are the timeouts a resource uses when its `timeouts` block doesn't set them
+type operationTimeouts struct {
+	Create time.Duration
+	Read   time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
+
+// reconcileTimeouts are used by resources that reconcile aliases and tags one request at a time after create and update
+var reconcileTimeouts = operationTimeouts{
+	Create: 5 * time.Minute,
+	Read:   2 * time.Minute,
+	Update: 5 * time.Minute,
+	Delete: 2 * time.Minute,
+}
+
+// timeoutsBlock is the `timeouts` block of resources whose operations send several requests or wait for OpsLevel
+func timeoutsBlock(ctx context.Context, defaults operationTimeouts) schema.Block {
+	description := func(operation string, defaultTimeout time.Duration) string {
+		return fmt.Sprintf("How long the %s may take in total, as a duration such as `30s` or `10m`. Defaults to `%s`.", operation, formatDuration(defaultTimeout))
+	}
+	block := timeouts.Block(ctx, timeouts.Opts{
+		Create:            true,
+		Read:              true,
+		Update:            true,
+		Delete:            true,
+		CreateDescription: description("create", defaults.Create),
+		ReadDescription:   description("read", defaults.Read),
+		UpdateDescription: description("update", defaults.Update),
+		DeleteDescription: description("delete", defaults.Delete),
+	})
+	if nestedBlock, ok := block.(schema.SingleNestedBlock); ok {
+		nestedBlock.Description = "How long each operation may take in total, including every request it sends and any wait for OpsLevel. " +
+			"Each request on its own is still limited by the provider `api_timeout`."
+		return nestedBlock
+	}
+	return block
+}
+
+// nullTimeouts is the value of a `timeouts` block that isn't configured
+func nullTimeouts() timeouts.Value {
+	return timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{
+		"create": types.StringType,
+		"read":   types.StringType,
+		"update": types.StringType,
+		"delete": types.StringType,
+	})}
+}
+
+// formatDuration drops the zero units time.Duration.String adds, 10m0s becomes 10m
+func formatDuration(duration time.Duration) string {
+	formatted := strings.TrimSuffix(duration.String(), "m0s")
+	if formatted != duration.String() {
+		formatted += "m"
+	}
+	if strings.HasSuffix(formatted, "h0m") {
+		formatted = strings.TrimSuffix(formatted, "0m")
+	}
+	return formatted
+}
+
+// operationTimeout reads one timeout of a `timeouts` block, such as timeouts.Value.Create
+type operationTimeout func(ctx context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics)
+
+// startOperation bounds ctx by the timeout of an operation and returns a client whose requests are cancelled once it expires,
+// so the timeout covers every request and wait of the operation together. Each request is still limited by api_timeout.
+func (d *CommonResourceClient) startOperation(ctx context.Context, timeout operationTimeout, defaultTimeout time.Duration, diags *diag.Diagnostics) (context.Context, context.CancelFunc, *opslevel.Client) {
+	duration, timeoutDiags := timeout(ctx, defaultTimeout)
+	diags.Append(timeoutDiags...)
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	if d.newClient == nil {
+		return ctx, cancel, d.client
+	}
+	return ctx, cancel, d.newClient(ctx)
+}
+
+// waitFor polls check until it reports done, returns an error or ctx is done.
+// Used where the API is eventually consistent, such as reading back an object right after creating it.
+func waitFor(ctx context.Context, check func() (bool, error)) error {
+	ticker := time.NewTicker(timeoutsPollInterval)
+	defer ticker.Stop()
+	for {
+		done, err := check()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("gave up waiting: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// waitForIntegration polls until a newly created integration can be read back
+func waitForIntegration(ctx context.Context, client *opslevel.Client, id opslevel.ID) error {
+	return waitFor(ctx, func() (bool, error) {
+		integration, err := client.GetIntegration(id)
+		if isNotFound(err, integration != nil && integration.Id != "") {
+			return false, nil
+		}
+		return err == nil, err
+	})
+}
@@ -0,0 +1,106 @@
+package opslevel
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/opslevel/opslevel-go/v2026"
+)
+
+func TestFormatDuration(t *testing.T) {
+	tests := map[string]struct {
+		duration time.Duration
+		expected string
+	}{
+		"seconds":           {duration: 30 * time.Second, expected: "30s"},
+		"minutes":           {duration: 10 * time.Minute, expected: "10m"},
+		"minutes, seconds":  {duration: 90 * time.Second, expected: "1m30s"},
+		"hours":             {duration: 2 * time.Hour, expected: "2h"},
+		"hours and minutes": {duration: 90 * time.Minute, expected: "1h30m"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if actual := formatDuration(tc.duration); actual != tc.expected {
+				t.Errorf("expected '%s' but got '%s'", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestStartOperation(t *testing.T) {
+	defaults := operationTimeouts{Create: time.Minute, Read: time.Second, Update: 2 * time.Minute, Delete: 3 * time.Minute}
+	given := timeouts.Value{Object: types.ObjectValueMust(nullTimeouts().AttributeTypes(context.Background()), map[string]attr.Value{
+		"create": types.StringValue("15m"),
+		"read":   types.StringNull(),
+		"update": types.StringNull(),
+		"delete": types.StringValue("45s"),
+	})}
+
+	tests := map[string]struct {
+		timeout        operationTimeout
+		defaultTimeout time.Duration
+		expected       time.Duration
+	}{
+		"no timeouts block": {timeout: nullTimeouts().Create, defaultTimeout: defaults.Create, expected: defaults.Create},
+		"set create":        {timeout: given.Create, defaultTimeout: defaults.Create, expected: 15 * time.Minute},
+		"unset read":        {timeout: given.Read, defaultTimeout: defaults.Read, expected: defaults.Read},
+		"set delete":        {timeout: given.Delete, defaultTimeout: defaults.Delete, expected: 45 * time.Second},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			var clientCtx context.Context
+			resource := CommonResourceClient{newClient: func(ctx context.Context) *opslevel.Client {
+				clientCtx = ctx
+				return &opslevel.Client{}
+			}}
+
+			start := time.Now()
+			ctx, cancel, client := resource.startOperation(context.Background(), tc.timeout, tc.defaultTimeout, &diags)
+			defer cancel()
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if client == nil || clientCtx != ctx {
+				t.Error("expected the client to be built with the operation context")
+			}
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				t.Fatal("expected the operation context to have a deadline")
+			}
+			if actual := deadline.Sub(start); actual < tc.expected || actual > tc.expected+time.Second {
+				t.Errorf("expected a deadline in '%s' but got '%s'", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestWaitFor(t *testing.T) {
+	calls := 0
+	err := waitFor(context.Background(), func() (bool, error) {
+		calls++
+		return true, nil
+	})
+	if err != nil || calls != 1 {
+		t.Errorf("expected a single successful check, got %d calls and error: %v", calls, err)
+	}
+
+	checkErr := errors.New("boom")
+	if err := waitFor(context.Background(), func() (bool, error) { return false, checkErr }); !errors.Is(err, checkErr) {
+		t.Errorf("expected the check error to be returned but got: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := waitFor(ctx, func() (bool, error) { return false, nil }); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected waiting to stop once the context is done but got: %v", err)
+	}
+}
@@ -35,6 +35,18 @@ data "opslevel_filter" "mock_filter" {
   }
 }
 
+# Infrastructure data sources
+
+data "opslevel_infrastructure" "mock_infrastructure" {
+  identifier = "orders-db"
+}
+
+data "opslevel_infrastructures" "mock_infrastructures" {
+  account       = "production"
+  owner         = "platform"
+  provider_type = "RDS Instance"
+}
+
 # Integration data sources
 
 data "opslevel_integration" "name_filter" {
@@ -0,0 +1,81 @@
+mock_provider "opslevel" {
+  alias  = "fake"
+  source = "./mock_datasource"
+}
+
+run "datasource_infrastructure" {
+  providers = {
+    opslevel = opslevel.fake
+  }
+
+  assert {
+    condition     = data.opslevel_infrastructure.mock_infrastructure.aliases == tolist(["orders-db", "orders_database"])
+    error_message = "wrong aliases in opslevel_infrastructure.aliases"
+  }
+
+  assert {
+    condition     = jsondecode(data.opslevel_infrastructure.mock_infrastructure.data).engine == "postgres"
+    error_message = "wrong data in opslevel_infrastructure.data"
+  }
+
+  assert {
+    condition     = data.opslevel_infrastructure.mock_infrastructure.id != null && data.opslevel_infrastructure.mock_infrastructure.id != ""
+    error_message = "opslevel_infrastructure id should not be empty"
+  }
+
+  assert {
+    condition     = data.opslevel_infrastructure.mock_infrastructure.identifier == "orders-db"
+    error_message = "wrong identifier in opslevel_infrastructure.identifier"
+  }
+
+  assert {
+    condition     = data.opslevel_infrastructure.mock_infrastructure.owner == "Z2lkOi8vb3BzbGV2ZWwvVGVhbS8xNjc4"
+    error_message = "wrong owner in opslevel_infrastructure.owner"
+  }
+
+  assert {
+    condition = data.opslevel_infrastructure.mock_infrastructure.provider_data == {
+      account = "production"
+      name    = "AWS"
+      type    = "RDS Instance"
+      url     = "https://console.aws.amazon.com/rds/home#database:id=orders-db"
+    }
+    error_message = "wrong provider_data in opslevel_infrastructure.provider_data"
+  }
+
+  assert {
+    condition     = data.opslevel_infrastructure.mock_infrastructure.schema == "Database"
+    error_message = "wrong schema in opslevel_infrastructure.schema"
+  }
+
+  assert {
+    condition     = data.opslevel_infrastructure.mock_infrastructure.tags == tolist(["env:production", "tier:1"])
+    error_message = "wrong tags in opslevel_infrastructure.tags"
+  }
+}
+
+run "datasource_infrastructures" {
+  providers = {
+    opslevel = opslevel.fake
+  }
+
+  assert {
+    condition     = data.opslevel_infrastructures.mock_infrastructures.account == "production" && data.opslevel_infrastructures.mock_infrastructures.owner == "platform" && data.opslevel_infrastructures.mock_infrastructures.provider_type == "RDS Instance"
+    error_message = "wrong filters in opslevel_infrastructures"
+  }
+
+  assert {
+    condition     = length(data.opslevel_infrastructures.mock_infrastructures.infrastructures) == 2
+    error_message = "wrong number of objects in opslevel_infrastructures"
+  }
+
+  assert {
+    condition     = [for infrastructure in data.opslevel_infrastructures.mock_infrastructures.infrastructures : infrastructure.name] == ["orders-db", "payments-db"]
+    error_message = "wrong names in opslevel_infrastructures"
+  }
+
+  assert {
+    condition     = alltrue([for infrastructure in data.opslevel_infrastructures.mock_infrastructures.infrastructures : infrastructure.provider_data.type == "RDS Instance"])
+    error_message = "wrong provider_data in opslevel_infrastructures"
+  }
+}
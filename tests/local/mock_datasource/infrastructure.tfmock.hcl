@@ -0,0 +1,54 @@
+mock_data "opslevel_infrastructure" {
+  defaults = {
+    # id intentionally omitted - will be assigned a random string
+    aliases = ["orders-db", "orders_database"]
+    data    = "{\"engine\":\"postgres\",\"zone\":\"us-east-1\"}"
+    name    = "orders-db"
+    owner   = "Z2lkOi8vb3BzbGV2ZWwvVGVhbS8xNjc4"
+    provider_data = {
+      account = "production"
+      name    = "AWS"
+      type    = "RDS Instance"
+      url     = "https://console.aws.amazon.com/rds/home#database:id=orders-db"
+    }
+    schema = "Database"
+    tags   = ["env:production", "tier:1"]
+  }
+}
+
+mock_data "opslevel_infrastructures" {
+  defaults = {
+    infrastructures = [
+      {
+        aliases = ["orders-db"]
+        data    = "{\"engine\":\"postgres\"}"
+        id      = "Z2lkOi8vb3BzbGV2ZWwvSW5mcmFzdHJ1Y3R1cmVSZXNvdXJjZS8x"
+        name    = "orders-db"
+        owner   = "Z2lkOi8vb3BzbGV2ZWwvVGVhbS8xNjc4"
+        provider_data = {
+          account = "production"
+          name    = "AWS"
+          type    = "RDS Instance"
+          url     = "https://console.aws.amazon.com/rds/home#database:id=orders-db"
+        }
+        schema = "Database"
+        tags   = ["env:production"]
+      },
+      {
+        aliases = ["payments-db"]
+        data    = "{\"engine\":\"mysql\"}"
+        id      = "Z2lkOi8vb3BzbGV2ZWwvSW5mcmFzdHJ1Y3R1cmVSZXNvdXJjZS8y"
+        name    = "payments-db"
+        owner   = "Z2lkOi8vb3BzbGV2ZWwvVGVhbS8xNjc4"
+        provider_data = {
+          account = "production"
+          name    = "AWS"
+          type    = "RDS Instance"
+          url     = "https://console.aws.amazon.com/rds/home#database:id=payments-db"
+        }
+        schema = "Database"
+        tags   = []
+      },
+    ]
+  }
+}